@@ -58,9 +58,10 @@ func (bs *Builders) buildAll(addons ...Addon) (*buildArtefacts, error) {
 		Subscription: ext.subscription(),
 		Harvest:      harvest,
 		Resources: &enclave.Resources{
-			Forest:     ext.forest(),
-			Supervisor: enclave.NewSupervisor(),
-			Binder:     harvest.Binder(),
+			Forest:      ext.forest(),
+			Supervisor:  enclave.NewSupervisor(),
+			Binder:      harvest.Binder(),
+			Hibernation: &enclave.Hibernation{},
 		},
 	})
 
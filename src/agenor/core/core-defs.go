@@ -45,6 +45,12 @@ type (
 		// about the traversal.
 		Metrics() Reporter
 
+		// Timings returns the histograms of the durations of directory reads, status
+		// queries and client callbacks, which are only recorded when instrumentation
+		// is enabled. This allows the client to determine whether the time spent
+		// was due to I/O or the client's own callback.
+		Timings() Chronicle
+
 		// Session returns the session information for the traversal, including
 		// timing and completion status. This allows the client to access details
 		// about the traversal session and determine if it completed successfully.
@@ -126,6 +132,14 @@ type (
 		// Hibernation represents the hibernation state of the traversal.
 		Hibernation enums.Hibernation
 
+		// HibernationSeen represents the number of nodes seen by hibernation, so
+		// that the wake after nodes trigger fires at the same node on resume.
+		HibernationSeen uint
+
+		// HibernationInvoked represents the number of nodes invoked by hibernation,
+		// so that the sleep after invokes trigger fires at the same node on resume.
+		HibernationInvoked uint
+
 		// CurrentPath represents the current path being processed during traversal. This allows
 		// the client to track the progress of the traversal and determine where it is in the
 		// file system hierarchy.
@@ -145,6 +159,29 @@ type (
 		// performance data and other relevant information about the traversal, which can be useful for
 		// monitoring and optimizing the traversal process.
 		Metrics Metrics
+
+		// Frontier represents the paths of the nodes that are yet to be visited, in the
+		// order they would have been visited, when navigating breadth first. The first
+		// is the node that was being processed. Empty, when navigating depth first.
+		Frontier []string
+
+		// Ancestry is the fingerprint of the directories from the tree down to the
+		// parent of the current path, used to detect whether the tree has drifted
+		// since the state was saved.
+		Ancestry []Landmark
+	}
+
+	// Landmark is the fingerprint of a directory on the ancestor chain of the
+	// current path.
+	Landmark struct {
+		// Path of the directory
+		Path string
+
+		// Modified is the modification time of the directory
+		Modified time.Time
+
+		// Entries is the number of entries in the directory
+		Entries int
 	}
 
 	// TimeFunc get time
@@ -58,8 +58,45 @@ type (
 		// all other fields are redundant, since the filter definitions inside
 		// Poly should be referred to instead.
 		Poly *PolyFilterDef
+
+		// Compound allows for the definition of a filter that combines other
+		// filter definitions, required when Type is FilterTypeCompound. The
+		// Scope, Negate and IfNotApplicable fields of the compound definition
+		// apply to the combined result.
+		Compound *CompoundFilterDef
 	}
 
+	// CompoundFilterDef defines a filter that combines any number of filter
+	// definitions, eg "glob *.flac AND NOT regex ^\._" is defined as an
+	// All compound containing the glob and a negated regex. Constituents
+	// may themselves be compound.
+	CompoundFilterDef struct {
+		// Mode specifies how the constituent filters are combined (mandatory)
+		Mode enums.CompoundMode
+
+		// Filters are the constituent filter definitions (mandatory)
+		Filters []FilterDef
+	}
+
+	// NamedFilterDef identifies a custom filter by the name under which its
+	// factory was registered (see RegisterFilter), along with the parameters
+	// the factory requires to create it. Unlike the custom filter itself, a
+	// named filter definition is persisted, so that the filter can be
+	// re-created on resume.
+	NamedFilterDef struct {
+		// Name of the registered filter factory (mandatory)
+		Name string
+
+		// Params serialised parameters passed to the factory; the encoding
+		// is chosen by the client (optional)
+		Params string
+	}
+
+	// FilterFactory creates a custom filter from its serialised parameters. The
+	// filter created must be a TraverseFilter when used as the node filter, or
+	// a SampleTraverseFilter when used as the sample filter.
+	FilterFactory func(params string) (any, error)
+
 	// PolyFilterDef defines a filter that can be applied to a directory's collection of entries
 	// when subscription is set to ScopeDirectory or ScopeAllEntries
 	PolyFilterDef struct {
@@ -129,6 +166,12 @@ type (
 		// Custom client defined sampling filter
 		//
 		Custom SampleTraverseFilter
+
+		// Named identifies the custom sampling filter, so that it can be
+		// re-created on resume. When Custom is not set, the filter is
+		// created from the registered factory.
+		//
+		Named *NamedFilterDef
 	}
 
 	// SampleTraverseFilter filter that can be applied to a directory's collection of entries
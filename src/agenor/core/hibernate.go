@@ -1,5 +1,9 @@
 package core
 
+import (
+	"time"
+)
+
 type (
 	// HibernationBehaviour defines hibernation behaviours
 	HibernationBehaviour struct {
@@ -12,6 +16,30 @@ type (
 		InclusiveSleep bool
 	}
 
+	// HibernateTriggers defines hibernation conditions that are based on
+	// counts, depth and time rather than on filters. A trigger composes with
+	// the filter for the same transition, so that the transition occurs at
+	// whichever condition is met first. A zero value means the trigger is
+	// not defined. Counts relate to the current navigation session only.
+	HibernateTriggers struct {
+		// WakeAfterNodes wakes once this number of nodes have been seen
+		WakeAfterNodes uint
+
+		// SleepAfterInvokes sleeps once the client has been invoked this
+		// number of times
+		SleepAfterInvokes uint
+
+		// WakeAtDepth wakes at the first node at this depth
+		WakeAtDepth uint
+
+		// SleepAtDepth sleeps at the first node at this depth
+		SleepAtDepth uint
+
+		// SleepAfter sleeps once this duration has elapsed since navigation
+		// began
+		SleepAfter time.Duration
+	}
+
 	// HibernateOptions defines hibernation options
 	HibernateOptions struct {
 		// WakeAt defines a filter for hibernation wake condition
@@ -20,15 +48,36 @@ type (
 		// SleepAt defines a filter for hibernation sleep condition
 		SleepAt *FilterDef
 
+		// ToggleAt defines a filter for the hibernation toggle condition;
+		// each node that matches flips hibernation between dormant and
+		// active, starting off dormant. Takes precedence over WakeAt and
+		// SleepAt.
+		ToggleAt *FilterDef
+
+		// Triggers defines wake and sleep conditions that are not based
+		// on filters
+		Triggers HibernateTriggers
+
 		// Behaviour contains hibernation behavioural aspects
 		Behaviour HibernationBehaviour
 	}
 )
 
-// IsHibernateActive returns true if either WakeAt or SleepAt is defined,
-// indicating that hibernation is active, and false otherwise. This can be
-// used to determine whether the hibernation functionality should be engaged
-// during traversal based on the presence of these filters.
+// IsHibernateActive returns true if any of WakeAt, SleepAt or ToggleAt is defined,
+// or any trigger, indicating that hibernation is active, and false otherwise. This
+// can be used to determine whether the hibernation functionality should be engaged
+// during traversal based on the presence of these conditions.
 func (o *HibernateOptions) IsHibernateActive() bool {
-	return o.WakeAt != nil || o.SleepAt != nil
+	return o.WakeAt != nil || o.SleepAt != nil || o.ToggleAt != nil ||
+		o.Triggers.IsWakeActive() || o.Triggers.IsSleepActive()
+}
+
+// IsWakeActive returns true if any wake trigger is defined
+func (t *HibernateTriggers) IsWakeActive() bool {
+	return t.WakeAfterNodes > 0 || t.WakeAtDepth > 0
+}
+
+// IsSleepActive returns true if any sleep trigger is defined
+func (t *HibernateTriggers) IsSleepActive() bool {
+	return t.SleepAfterInvokes > 0 || t.SleepAtDepth > 0 || t.SleepAfter > 0
 }
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"encoding/binary"
 	"encoding/json"
+	"io"
 	"maps"
 	"sync/atomic"
 
@@ -9,8 +11,9 @@ import (
 )
 
 type (
-	// MetricValue represents the value of a metric
-	MetricValue = uint32
+	// MetricValue represents the value of a metric. It is wide enough to
+	// accommodate byte totals as well as counts.
+	MetricValue = uint64
 
 	// Metric represents query access to the metric. The client
 	// registering the metric should maintain it's mutate access
@@ -39,7 +42,18 @@ type (
 		// can be used to track occurrences of specific events or conditions during the
 		// traversal process in a more flexible way, allowing the client to update the metric
 		// by any desired amount as needed.
-		Times(increment uint) MetricValue
+		Times(increment MetricValue) MetricValue
+
+		// Peak records the value if it is greater than the current value and
+		// returns the resultant value. This is used by metrics that represent
+		// the highest value encountered during traversal, such as the size of
+		// the largest file, rather than an accumulation.
+		Peak(value MetricValue) MetricValue
+
+		// Set records the value, replacing the current value. This is used by
+		// metrics that represent the most recently measured value, such as
+		// the current rate of throttled navigation, rather than an accumulation.
+		Set(value MetricValue)
 	}
 
 	// NavigationMetric represents a specific metric being tracked during the traversal process,
@@ -55,7 +69,7 @@ type (
 		// the performance data or other relevant information that the metric represents during
 		// the traversal process. This field is mutable and can be updated using the Tick and
 		// Times methods of the MutableMetric interface.
-		Counter atomic.Uint32
+		Counter atomic.Uint64
 	}
 
 	// Metrics represents a collection of metrics being tracked during the traversal process,
@@ -70,6 +84,9 @@ type (
 		// access the performance data or other relevant information that the metric represents.
 		// This can be used for monitoring, reporting, or making decisions based on the metric's
 		// value during the traversal process.
+		//
+		// As well as counts, byte totals (eg MetricNoFileBytesInvoked) and peak values
+		// (eg MetricLargestFileSize) are reported in the same way.
 		Count(enums.Metric) MetricValue
 	}
 )
@@ -102,12 +119,27 @@ func (m *NavigationMetric) Times(increment MetricValue) MetricValue {
 	return m.Counter.Add(increment)
 }
 
+// Peak records the value if it is greater than the current value and returns
+// the resultant value.
+func (m *NavigationMetric) Peak(value MetricValue) MetricValue {
+	for {
+		current := m.Counter.Load()
+		if value <= current {
+			return current
+		}
+
+		if m.Counter.CompareAndSwap(current, value) {
+			return value
+		}
+	}
+}
+
 // MarshalJSON encodes the metric so that the counter can be persisted and restored
-// across resume sessions. The atomic counter is represented as a plain uint32.
+// across resume sessions. The atomic counter is represented as a plain uint64.
 func (m *NavigationMetric) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		T       enums.Metric `json:"T"`
-		Counter uint32       `json:"Counter"`
+		Counter uint64       `json:"Counter"`
 	}{
 		T:       m.T,
 		Counter: m.Counter.Load(),
@@ -118,7 +150,7 @@ func (m *NavigationMetric) MarshalJSON() ([]byte, error) {
 func (m *NavigationMetric) UnmarshalJSON(data []byte) error {
 	var decoded struct {
 		T       enums.Metric `json:"T"`
-		Counter uint32       `json:"Counter"`
+		Counter uint64       `json:"Counter"`
 	}
 
 	if err := json.Unmarshal(data, &decoded); err != nil {
@@ -130,9 +162,42 @@ func (m *NavigationMetric) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GobEncode encodes the metric for persistence in the binary gob format, for
+// the same reason as MarshalJSON.
+func (m *NavigationMetric) GobEncode() ([]byte, error) {
+	data := binary.AppendUvarint(nil, uint64(m.T))
+
+	return binary.AppendUvarint(data, m.Counter.Load()), nil
+}
+
+// GobDecode decodes the persisted metric state and restores the atomic counter.
+func (m *NavigationMetric) GobDecode(data []byte) error {
+	t, n := binary.Uvarint(data)
+	if n <= 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	counter, c := binary.Uvarint(data[n:])
+	if c <= 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	m.T = enums.Metric(t)
+	m.Counter.Store(counter)
+
+	return nil
+}
+
+// Set records the value, replacing the current value.
+func (m *NavigationMetric) Set(value MetricValue) {
+	m.Counter.Store(value)
+}
+
 // Merge merges another Metrics collection into the current one, combining the values of
 // metrics with the same type. If a metric type exists in both collections, their values
-// are added together. If a metric type exists only in the other collection, it is added
+// are added together, unless the metric represents a peak, in which case the greater
+// of the two values is retained, or a gauge, in which case the other value is retained.
+// If a metric type exists only in the other collection, it is added
 // to the current collection. This allows for aggregating metrics from different sources
 // or stages of the traversal process, providing a comprehensive view of the performance
 // and behavior of the traversal.
@@ -140,7 +205,14 @@ func (m Metrics) Merge(other Metrics) {
 	for mt := range maps.Keys(m) {
 		if om, foundOther := other[mt]; foundOther {
 			if metric, found := m[mt]; found {
-				metric.Times(om.Counter.Load())
+				switch {
+				case mt.IsPeak():
+					metric.Peak(om.Counter.Load())
+				case mt.IsGauge():
+					metric.Set(om.Counter.Load())
+				default:
+					metric.Times(om.Counter.Load())
+				}
 			} else {
 				metric := &NavigationMetric{T: mt}
 				metric.Counter.Store(om.Counter.Load())
@@ -149,3 +221,17 @@ func (m Metrics) Merge(other Metrics) {
 		}
 	}
 }
+
+// Clone creates a copy of the collection, capturing the current value of each
+// metric, so that the copy is unaffected by subsequent updates to the original.
+func (m Metrics) Clone() Metrics {
+	c := make(Metrics, len(m))
+
+	for mt, metric := range m {
+		clone := &NavigationMetric{T: metric.T}
+		clone.Counter.Store(metric.Counter.Load())
+		c[mt] = clone
+	}
+
+	return c
+}
@@ -23,6 +23,7 @@ type Node struct {
 	Error     error         // error encountered when creating this node, if any
 	Children  []fs.DirEntry // children of this node, if it is a directory.
 	Parent    *Node         // parent of this node, nil if this is the tree node
+	Link      string        // target of the symbolic link represented by this node, if any
 	dir       bool          // indicates whether this node is a directory
 }
 
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+)
+
+// SurveyResult is the outcome of a survey, which establishes the extent of
+// a navigation prior to it being performed, eg so that progress can be
+// reported against the total number of nodes.
+type SurveyResult struct {
+	// Files is the number of files that would be invoked
+	Files uint
+
+	// Directories is the number of directories that would be invoked
+	Directories uint
+
+	// Bytes is the total size of the files that would be invoked
+	Bytes uint64
+
+	// MaxDepth is the depth of the deepest node that would be invoked
+	MaxDepth TraversalDepth
+}
+
+// Count returns the number of nodes that would be invoked for the
+// subscription.
+func (r *SurveyResult) Count(subscription enums.Subscription) uint {
+	switch subscription {
+	case enums.SubscribeFiles:
+		return r.Files
+
+	case enums.SubscribeDirectories, enums.SubscribeDirectoriesWithFiles:
+		return r.Directories
+
+	case enums.SubscribeUniversal:
+		return r.Files + r.Directories
+
+	case enums.SubscribeUndefined:
+	}
+
+	return 0
+}
+
+// Tally accounts for a node that would be invoked.
+func (r *SurveyResult) Tally(node *Node) {
+	r.MaxDepth = max(r.MaxDepth, node.Extension.Depth)
+
+	if node.IsDirectory() {
+		r.Directories++
+
+		return
+	}
+
+	r.Files++
+
+	if node.Info != nil {
+		r.Bytes += uint64(max(node.Info.Size(), 0)) //nolint:gosec // ok
+	}
+}
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"math/bits"
+	"sync/atomic"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+)
+
+// NoOfLatencyBuckets is the number of buckets of a LatencyHistogram. The
+// upper bound of each bucket is double that of the previous, starting
+// at 1 microsecond, so the last bucket accommodates durations in excess
+// of 6 days.
+const NoOfLatencyBuckets = 40
+
+type (
+	// Histogram represents query access to the durations recorded for an
+	// instrument.
+	Histogram interface {
+		// Instrument returns the operation whose durations are recorded
+		Instrument() enums.Instrument
+
+		// Count returns the number of durations recorded
+		Count() uint64
+
+		// Total returns the sum of all durations recorded, ie the total
+		// time spent performing the operation.
+		Total() time.Duration
+
+		// Max returns the longest duration recorded
+		Max() time.Duration
+
+		// Mean returns the average duration recorded
+		Mean() time.Duration
+
+		// Percentile returns an estimate of the duration within which the
+		// percentage p (0-100) of operations completed. Since durations are
+		// aggregated into buckets, the estimate is the upper bound of the
+		// bucket in which the percentile falls, capped at the longest
+		// duration recorded.
+		Percentile(p float64) time.Duration
+
+		// Buckets returns the number of durations recorded into each bucket,
+		// see LatencyBound.
+		Buckets() []uint64
+	}
+
+	// LatencyHistogram aggregates durations into buckets of exponentially
+	// increasing size, so that the memory required is constant, regardless
+	// of the number of operations recorded. It is safe for concurrent use.
+	LatencyHistogram struct {
+		// T represents the operation whose durations are recorded
+		T enums.Instrument
+
+		counts [NoOfLatencyBuckets]atomic.Uint64
+		n      atomic.Uint64
+		total  atomic.Int64
+		max    atomic.Int64
+	}
+
+	// Timings represents a collection of histograms, identified by instrument.
+	Timings map[enums.Instrument]*LatencyHistogram
+
+	// Chronicle represents query access to the timings recorded during
+	// traversal. Timings are only recorded when instrumentation is enabled,
+	// otherwise all histograms are empty.
+	Chronicle interface {
+		// Latency returns the histogram of the specified instrument
+		Latency(enums.Instrument) Histogram
+	}
+)
+
+// LatencyBound returns the upper bound of the bucket at index i
+func LatencyBound(i int) time.Duration {
+	return time.Microsecond << i
+}
+
+// Record adds the duration to the histogram
+func (h *LatencyHistogram) Record(d time.Duration) {
+	d = max(d, 0)
+	index := 0
+
+	if d > time.Microsecond {
+		index = min(
+			bits.Len64(uint64((d-1)/time.Microsecond)), //nolint:gosec // ok, positive
+			NoOfLatencyBuckets-1,
+		)
+	}
+
+	h.counts[index].Add(1)
+	h.n.Add(1)
+	h.total.Add(int64(d))
+
+	for {
+		current := h.max.Load()
+		if int64(d) <= current || h.max.CompareAndSwap(current, int64(d)) {
+			break
+		}
+	}
+}
+
+// Instrument returns the operation whose durations are recorded
+func (h *LatencyHistogram) Instrument() enums.Instrument {
+	return h.T
+}
+
+// Count returns the number of durations recorded
+func (h *LatencyHistogram) Count() uint64 {
+	return h.n.Load()
+}
+
+// Total returns the sum of all durations recorded
+func (h *LatencyHistogram) Total() time.Duration {
+	return time.Duration(h.total.Load())
+}
+
+// Max returns the longest duration recorded
+func (h *LatencyHistogram) Max() time.Duration {
+	return time.Duration(h.max.Load())
+}
+
+// Mean returns the average duration recorded
+func (h *LatencyHistogram) Mean() time.Duration {
+	if n := h.Count(); n > 0 {
+		return h.Total() / time.Duration(n) //nolint:gosec // ok
+	}
+
+	return 0
+}
+
+// Percentile returns an estimate of the duration within which the
+// percentage p of operations completed.
+func (h *LatencyHistogram) Percentile(p float64) time.Duration {
+	n := h.Count()
+	if n == 0 {
+		return 0
+	}
+
+	rank := uint64(float64(n) * min(max(p, 0), 100) / 100)
+	rank = max(rank, 1)
+
+	var cumulative uint64
+
+	for i := range h.counts {
+		cumulative += h.counts[i].Load()
+
+		if cumulative >= rank {
+			return min(LatencyBound(i), h.Max())
+		}
+	}
+
+	return h.Max()
+}
+
+// Buckets returns the number of durations recorded into each bucket
+func (h *LatencyHistogram) Buckets() []uint64 {
+	result := make([]uint64, NoOfLatencyBuckets)
+
+	for i := range h.counts {
+		result[i] = h.counts[i].Load()
+	}
+
+	return result
+}
@@ -0,0 +1,79 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+)
+
+func TestLatencyHistogramBuckets(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		bucket   int
+	}{
+		{name: "zero", duration: 0, bucket: 0},
+		{name: "bound of first", duration: time.Microsecond, bucket: 0},
+		{name: "above first", duration: time.Microsecond + 1, bucket: 1},
+		{name: "bound of second", duration: 2 * time.Microsecond, bucket: 1},
+		{name: "within third", duration: 3 * time.Microsecond, bucket: 2},
+		{name: "millisecond", duration: time.Millisecond, bucket: 10},
+		{name: "beyond last", duration: 1000 * time.Hour, bucket: core.NoOfLatencyBuckets - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histogram := &core.LatencyHistogram{}
+			histogram.Record(tt.duration)
+
+			if actual := histogram.Buckets()[tt.bucket]; actual != 1 {
+				t.Fatalf("expected duration %v in bucket %v", tt.duration, tt.bucket)
+			}
+		})
+	}
+}
+
+func TestLatencyHistogramSummary(t *testing.T) {
+	histogram := &core.LatencyHistogram{}
+
+	for range 90 {
+		histogram.Record(100 * time.Microsecond)
+	}
+
+	for range 10 {
+		histogram.Record(30 * time.Millisecond)
+	}
+
+	if actual := histogram.Count(); actual != 100 {
+		t.Fatalf("expected count 100, got %v", actual)
+	}
+
+	if actual := histogram.Max(); actual != 30*time.Millisecond {
+		t.Fatalf("expected max 30ms, got %v", actual)
+	}
+
+	if actual := histogram.Total(); actual != 9*time.Millisecond+300*time.Millisecond {
+		t.Fatalf("expected total 309ms, got %v", actual)
+	}
+
+	if actual := histogram.Mean(); actual != 3090*time.Microsecond {
+		t.Fatalf("expected mean 3.09ms, got %v", actual)
+	}
+
+	if actual := histogram.Percentile(50); actual != core.LatencyBound(7) {
+		t.Fatalf("expected p50 %v, got %v", core.LatencyBound(7), actual)
+	}
+
+	if actual := histogram.Percentile(99); actual != 30*time.Millisecond {
+		t.Fatalf("expected p99 capped at max, got %v", actual)
+	}
+}
+
+func TestLatencyHistogramEmpty(t *testing.T) {
+	histogram := &core.LatencyHistogram{}
+
+	if histogram.Mean() != 0 || histogram.Percentile(50) != 0 {
+		t.Fatal("expected empty histogram to report zero")
+	}
+}
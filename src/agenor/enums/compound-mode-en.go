@@ -0,0 +1,23 @@
+package enums
+
+//go:generate stringer -type=CompoundMode -linecomment -trimprefix=CompoundMode -output compound-mode-en-auto.go
+
+// CompoundMode defines how the constituent filters of a compound filter
+// are combined.
+type CompoundMode uint
+
+const (
+	// CompoundModeUndefined represents the undefined compound mode
+	CompoundModeUndefined CompoundMode = iota // undefined-compound-mode
+
+	// CompoundModeAll matches when all of the constituent filters match
+	CompoundModeAll // all
+
+	// CompoundModeAny matches when at least one of the constituent filters
+	// matches
+	CompoundModeAny // any
+
+	// CompoundModeNot matches when none of the constituent filters match;
+	// with a single constituent, this is its negation.
+	CompoundModeNot // not
+)
@@ -0,0 +1,27 @@
+// Code generated by "stringer -type=DriftPolicy -linecomment -trimprefix=Drift -output drift-policy-en-auto.go"; DO NOT EDIT.
+
+package enums
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[DriftUndefined-0]
+	_ = x[DriftWarn-1]
+	_ = x[DriftFail-2]
+	_ = x[DriftNearest-3]
+}
+
+const _DriftPolicy_name = "drift-undefineddrift-warndrift-faildrift-nearest"
+
+var _DriftPolicy_index = [...]uint8{0, 15, 25, 35, 48}
+
+func (i DriftPolicy) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_DriftPolicy_index)-1 {
+		return "DriftPolicy(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _DriftPolicy_name[_DriftPolicy_index[idx]:_DriftPolicy_index[idx+1]]
+}
@@ -0,0 +1,22 @@
+package enums
+
+//go:generate stringer -type=DriftPolicy -linecomment -trimprefix=Drift -output drift-policy-en-auto.go
+
+// DriftPolicy determines how a resume responds to the tree having changed
+// since the navigation state was saved
+type DriftPolicy uint
+
+const (
+	// DriftUndefined undefined, treated as DriftWarn
+	DriftUndefined DriftPolicy = iota // drift-undefined
+
+	// DriftWarn logs a warning and resumes regardless
+	DriftWarn // drift-warn
+
+	// DriftFail fails the resume
+	DriftFail // drift-fail
+
+	// DriftNearest resumes from the nearest surviving ancestor of the
+	// node at which navigation was saved
+	DriftNearest // drift-nearest
+)
@@ -14,11 +14,14 @@ func _() {
 	_ = x[FilterTypeGlob-3]
 	_ = x[FilterTypeCustom-4]
 	_ = x[FilterTypePoly-5]
+	_ = x[FilterTypeExpression-6]
+	_ = x[FilterTypeCompound-7]
+	_ = x[FilterTypeIgnore-8]
 }
 
-const _FilterType_name = "undefined-filterglob-ex-filterregex-filterglob-filtercustom-filterpoly-filter"
+const _FilterType_name = "undefined-filterglob-ex-filterregex-filterglob-filtercustom-filterpoly-filterexpression-filtercompound-filterignore-filter"
 
-var _FilterType_index = [...]uint8{0, 16, 30, 42, 53, 66, 77}
+var _FilterType_index = [...]uint8{0, 16, 30, 42, 53, 66, 77, 94, 109, 122}
 
 func (i FilterType) String() string {
 	idx := int(i) - 0
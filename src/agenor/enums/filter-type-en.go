@@ -36,4 +36,24 @@ const (
 	// FilterTypePoly poly filter
 	//
 	FilterTypePoly // poly-filter
+
+	// FilterTypeExpression filters on the attributes of a node's file info,
+	// rather than its name, by evaluating a boolean expression, eg:
+	// "size > 50MB && mtime < 7d". See filtering.Expression for the
+	// supported attributes and syntax.
+	//
+	FilterTypeExpression // expression-filter
+
+	// FilterTypeCompound combines any number of filter definitions with
+	// all, any or not semantics (see FilterDef.Compound).
+	//
+	FilterTypeCompound // compound-filter
+
+	// FilterTypeIgnore applies the rules of ignore files, eg ".gitignore",
+	// found in the directories being navigated, to their sub-trees. The
+	// pattern is a csv of the names of the ignore files to read. See
+	// filtering.Ignore for the supported syntax. Not supported when
+	// navigating breadth first.
+	//
+	FilterTypeIgnore // ignore-filter
 )
@@ -0,0 +1,28 @@
+package enums
+
+//go:generate stringer -type=Instrument -linecomment -trimprefix=Instrument -output instrument-en-auto.go
+
+// Instrument identifies an operation whose duration is recorded when
+// instrumentation is enabled.
+type Instrument uint
+
+const (
+	_ Instrument = iota
+
+	// InstrumentReadDirectory represents the reading of a directory's
+	// contents via the ReadDirectory hook
+	//
+	InstrumentReadDirectory // instrument-read-directory
+
+	// InstrumentQueryStatus represents the querying of a node's status via
+	// the QueryStatus hook. The hook is only invoked for the top node and,
+	// when resuming, for the tree and the ancestors of the resume point; the
+	// status of every other node comes from the entries read from its
+	// directory, so is covered by InstrumentReadDirectory.
+	//
+	InstrumentQueryStatus // instrument-query-status
+
+	// InstrumentClient represents the invocation of the client's callback
+	//
+	InstrumentClient // instrument-client
+)
@@ -0,0 +1,27 @@
+// Code generated by "stringer -type=LinkMode -linecomment -trimprefix=LinkMode -output link-mode-en-auto.go"; DO NOT EDIT.
+
+package enums
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LinkModeUndefined-0]
+	_ = x[LinkModeIgnore-1]
+	_ = x[LinkModeReport-2]
+	_ = x[LinkModeFollow-3]
+}
+
+const _LinkMode_name = "undefined-link-modeignorereportfollow"
+
+var _LinkMode_index = [...]uint8{0, 19, 25, 31, 37}
+
+func (i LinkMode) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_LinkMode_index)-1 {
+		return "LinkMode(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _LinkMode_name[_LinkMode_index[idx]:_LinkMode_index[idx+1]]
+}
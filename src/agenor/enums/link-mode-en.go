@@ -0,0 +1,23 @@
+package enums
+
+//go:generate stringer -type=LinkMode -linecomment -trimprefix=LinkMode -output link-mode-en-auto.go
+
+// LinkMode defines how the navigator treats symbolic links encountered
+// during traversal.
+type LinkMode uint
+
+const (
+	// LinkModeUndefined is the default mode and behaves as LinkModeReport
+	LinkModeUndefined LinkMode = iota // undefined-link-mode
+
+	// LinkModeIgnore links are not delivered to the client
+	LinkModeIgnore // ignore
+
+	// LinkModeReport links are delivered as non directory nodes, with the
+	// link target populated, but are never followed
+	LinkModeReport // report
+
+	// LinkModeFollow links to directories are followed and navigated as if
+	// they were regular directories, with cycle detection.
+	LinkModeFollow // follow
+)
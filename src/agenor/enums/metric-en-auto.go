@@ -15,11 +15,20 @@ func _() {
 	_ = x[MetricNoChildFilesFound-5]
 	_ = x[MetricNoChildFilesFilteredOut-6]
 	_ = x[MetricNoNodesSkipped-7]
+	_ = x[MetricNoMountPointsSkipped-8]
+	_ = x[MetricNoDirectoriesPruned-9]
+	_ = x[MetricNoFileBytesInvoked-10]
+	_ = x[MetricNoFileBytesFilteredOut-11]
+	_ = x[MetricLargestFileSize-12]
+	_ = x[MetricDeepestDepth-13]
+	_ = x[MetricNodeRate-14]
+	_ = x[MetricReadRate-15]
+	_ = x[MetricByteRate-16]
 }
 
-const _Metric_name = "metric-no-of-filesmetric-no-of-files-filtered-outmetric-no-of-directoriesmetric-no-of-directories-filtered-outmetric-no-of-child-files-foundmetric-no-of-child-files-foundmetric-no-of-nodes-skipped"
+const _Metric_name = "metric-no-of-filesmetric-no-of-files-filtered-outmetric-no-of-directoriesmetric-no-of-directories-filtered-outmetric-no-of-child-files-foundmetric-no-of-child-files-foundmetric-no-of-nodes-skippedmetric-no-of-mount-points-skippedmetric-no-of-directories-prunedmetric-no-of-file-bytesmetric-no-of-file-bytes-filtered-outmetric-largest-file-sizemetric-deepest-depthmetric-node-ratemetric-read-ratemetric-byte-rate"
 
-var _Metric_index = [...]uint8{0, 18, 49, 73, 110, 140, 170, 196}
+var _Metric_index = [...]uint16{0, 18, 49, 73, 110, 140, 170, 196, 229, 260, 283, 319, 343, 363, 379, 395, 411}
 
 func (i Metric) String() string {
 	idx := int(i) - 1
@@ -42,4 +42,62 @@ const (
 	// for reasons other than being filtered out.
 	//
 	MetricNoNodesSkipped // metric-no-of-nodes-skipped
+
+	// MetricNoMountPointsSkipped represents the number of directories not descended
+	// because they reside on a different device to the tree (see OneFileSystem).
+	//
+	MetricNoMountPointsSkipped // metric-no-of-mount-points-skipped
+
+	// MetricNoDirectoriesPruned represents the number of directories not
+	// descended because they match the prune filter.
+	//
+	MetricNoDirectoriesPruned // metric-no-of-directories-pruned
+
+	// MetricNoFileBytesInvoked represents the total size in bytes of the files
+	// invoked for during traversal
+	//
+	MetricNoFileBytesInvoked // metric-no-of-file-bytes
+
+	// MetricNoFileBytesFilteredOut represents the total size in bytes of the
+	// files filtered out
+	//
+	MetricNoFileBytesFilteredOut // metric-no-of-file-bytes-filtered-out
+
+	// MetricLargestFileSize represents the size in bytes of the largest file
+	// invoked for during traversal
+	//
+	MetricLargestFileSize // metric-largest-file-size
+
+	// MetricDeepestDepth represents the depth of the deepest directory
+	// descended during traversal
+	//
+	MetricDeepestDepth // metric-deepest-depth
+
+	// MetricNodeRate represents the number of nodes invoked for per second,
+	// measured over the most recent second, when throttling is active
+	//
+	MetricNodeRate // metric-node-rate
+
+	// MetricReadRate represents the number of directories read per second,
+	// measured over the most recent second, when throttling is active
+	//
+	MetricReadRate // metric-read-rate
+
+	// MetricByteRate represents the size in bytes of the files invoked for
+	// per second, measured over the most recent second, when throttling is
+	// active
+	//
+	MetricByteRate // metric-byte-rate
 )
+
+// IsPeak determines whether the metric represents the highest value
+// encountered during traversal, rather than an accumulation.
+func (m Metric) IsPeak() bool {
+	return m == MetricLargestFileSize || m == MetricDeepestDepth
+}
+
+// IsGauge determines whether the metric represents the most recently
+// measured value, rather than an accumulation.
+func (m Metric) IsGauge() bool {
+	return m == MetricNodeRate || m == MetricReadRate || m == MetricByteRate
+}
@@ -10,11 +10,13 @@ func _() {
 	var x [1]struct{}
 	_ = x[PersistUndefined-0]
 	_ = x[PersistJSON-1]
+	_ = x[PersistYAML-2]
+	_ = x[PersistGob-3]
 }
 
-const _PersistenceFormat_name = "persistence-undefinedpersist-json"
+const _PersistenceFormat_name = "persistence-undefinedpersist-jsonpersist-yamlpersist-gob"
 
-var _PersistenceFormat_index = [...]uint8{0, 21, 33}
+var _PersistenceFormat_index = [...]uint8{0, 21, 33, 45, 56}
 
 func (i PersistenceFormat) String() string {
 	idx := int(i) - 0
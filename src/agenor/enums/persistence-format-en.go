@@ -11,4 +11,10 @@ const (
 
 	// PersistJSON persist in JSON
 	PersistJSON // persist-json
+
+	// PersistYAML persist in YAML
+	PersistYAML // persist-yaml
+
+	// PersistGob persist in the compact binary gob format
+	PersistGob // persist-gob
 )
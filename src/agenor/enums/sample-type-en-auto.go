@@ -12,11 +12,15 @@ func _() {
 	_ = x[SampleTypeSlice-1]
 	_ = x[SampleTypeFilter-2]
 	_ = x[SampleTypeCustom-3]
+	_ = x[SampleTypeSize-4]
+	_ = x[SampleTypeModified-5]
+	_ = x[SampleTypeRandom-6]
+	_ = x[SampleTypeStride-7]
 }
 
-const _SampleType_name = "undefined-sampleslice-samplefilter-samplecustom-sample"
+const _SampleType_name = "undefined-sampleslice-samplefilter-samplecustom-samplesize-samplemodified-samplerandom-samplestride-sample"
 
-var _SampleType_index = [...]uint8{0, 16, 28, 41, 54}
+var _SampleType_index = [...]uint8{0, 16, 28, 41, 54, 65, 80, 93, 106}
 
 func (i SampleType) String() string {
 	idx := int(i) - 0
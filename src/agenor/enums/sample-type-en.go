@@ -17,4 +17,19 @@ const (
 
 	// SampleTypeCustom custom sample type
 	SampleTypeCustom // custom-sample
+
+	// SampleTypeSize size sample type; selects the largest entries, or the
+	// smallest when sampling in reverse
+	SampleTypeSize // size-sample
+
+	// SampleTypeModified modified sample type; selects the most recently
+	// modified entries, or the least recently modified when sampling in reverse
+	SampleTypeModified // modified-sample
+
+	// SampleTypeRandom random sample type; selects a reproducible random
+	// sample, determined by the seed
+	SampleTypeRandom // random-sample
+
+	// SampleTypeStride stride sample type; selects every k-th entry
+	SampleTypeStride // stride-sample
 )
@@ -0,0 +1,26 @@
+// Code generated by "stringer -type=TraversalOrder -linecomment -trimprefix=TraversalOrder -output traversal-order-en-auto.go"; DO NOT EDIT.
+
+package enums
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TraversalOrderUndefined-0]
+	_ = x[TraversalOrderDepthFirst-1]
+	_ = x[TraversalOrderBreadthFirst-2]
+}
+
+const _TraversalOrder_name = "undefined-traversal-orderdepth-firstbreadth-first"
+
+var _TraversalOrder_index = [...]uint8{0, 25, 36, 49}
+
+func (i TraversalOrder) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_TraversalOrder_index)-1 {
+		return "TraversalOrder(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TraversalOrder_name[_TraversalOrder_index[idx]:_TraversalOrder_index[idx+1]]
+}
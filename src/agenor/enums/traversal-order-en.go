@@ -0,0 +1,21 @@
+package enums
+
+//go:generate stringer -type=TraversalOrder -linecomment -trimprefix=TraversalOrder -output traversal-order-en-auto.go
+
+// TraversalOrder defines the order in which the navigator visits the
+// nodes of the tree.
+type TraversalOrder uint
+
+const (
+	// TraversalOrderUndefined is the default order and behaves as
+	// TraversalOrderDepthFirst
+	TraversalOrderUndefined TraversalOrder = iota // undefined-traversal-order
+
+	// TraversalOrderDepthFirst the contents of a directory are navigated
+	// in their entirety before that directory's following siblings
+	TraversalOrderDepthFirst // depth-first
+
+	// TraversalOrderBreadthFirst all nodes at one depth are navigated
+	// before any node at the next depth, ie level order
+	TraversalOrderBreadthFirst // breadth-first
+)
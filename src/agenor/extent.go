@@ -7,6 +7,7 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/internal/feat/resume"
 	"github.com/snivilised/jaywalk/src/agenor/internal/kernel"
 	"github.com/snivilised/jaywalk/src/agenor/internal/opts"
+	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/jaywalk/src/third/lo"
 )
@@ -86,8 +87,15 @@ func (x *resumeExtent) plugin(artefacts *kernel.Artefacts) enclave.Plugin {
 func (x *resumeExtent) options(addons []Addon,
 	settings ...pref.Option,
 ) (enclave.OptionHarvest, error) {
+	from, err := x.restoration()
+	if err != nil {
+		return &optionHarvest{
+			loaded: &opts.LoadInfo{},
+		}, err
+	}
+
 	loaded, binder, err := resume.Load(&enclave.RestoreState{
-		Path:     x.relic.From,
+		Path:     from,
 		FS:       x.trees.R,
 		Strategy: x.relic.Strategy,
 	}, settings...)
@@ -105,6 +113,25 @@ func (x *resumeExtent) options(addons []Addon,
 	}, err
 }
 
+// restoration returns the path of the resumption file, which when not
+// specified explicitly, is the most recently saved for the Latest tree.
+func (x *resumeExtent) restoration() (string, error) {
+	if x.relic.From != "" || x.relic.Latest == "" {
+		return x.relic.From, nil
+	}
+
+	admin := lo.Ternary(x.relic.Admin != "",
+		x.relic.Admin, pref.DefaultOptions().Monitor.Admin.Path,
+	)
+
+	checkpoint, err := persist.NewCatalogue(x.trees.R, admin).Latest(x.relic.Latest)
+	if err != nil {
+		return "", err
+	}
+
+	return checkpoint.Path, nil
+}
+
 func (x *resumeExtent) complete() bool {
 	return x.pin.IfResult.IsComplete()
 }
@@ -9,7 +9,9 @@ func Walk() NavigatorFactory {
 	return &walkerFac{}
 }
 
-// Sprint requests a concurrent traversal of a directory tree.
+// Sprint requests a concurrent traversal of a directory tree. The client
+// callback is invoked on a worker pool of size NoW (see WithNoW/WithCPU);
+// wg is the wait group the pool uses to signal that it has drained.
 func Sprint(wg pants.WaitGroup) NavigatorFactory {
 	return &runnerFac{
 		wg: wg,
@@ -63,7 +65,8 @@ func (f *runnerFac) Configure(addons ...Addon) Director {
 						ext: artefacts.ext,
 						err: err,
 					},
-					wg: f.wg,
+					wg:        f.wg,
+					swappable: artefacts.swappable,
 				},
 				plugins: artefacts.plugins,
 			},
@@ -46,8 +46,9 @@ type (
 	Swapper interface {
 		// Swap replaces the underlying client handler with the provided decorator. This
 		// is used to allow the guardian to be decorated with different handlers, such as
-		// filters or a master sealer.
-		Swap(decorator core.Client)
+		// filters or a master sealer. The handler being replaced is returned, so that
+		// the decorator can delegate to it.
+		Swap(decorator core.Client) core.Client
 	}
 
 	// Guardian is the gateway to accessing the invocation chain.
@@ -116,6 +117,13 @@ type (
 		// allows the kernel to perform any necessary cleanup and to ensure that all resources
 		// are properly released.
 		Bye(result core.TraverseResult)
+
+		// Conclude is invoked once navigation has finished, with the error that it
+		// finished with, including all the child navigations required by resume.
+		// This allows the kernel to complete any background work, such as the
+		// writing of checkpoints. It is invoked exactly once, by the outermost
+		// navigation.
+		Conclude(err error)
 	}
 
 	// PluginInit defines the properties required to initialize a plugin. This is used
@@ -161,6 +169,10 @@ type (
 		// steps in the navigation process based on the structure of the file system.
 		Read(path string) ([]fs.DirEntry, error)
 
+		// Query allows the mediator to get the status of the entity at the
+		// specified path, via the QueryStatus hook.
+		Query(path string) (fs.FileInfo, error)
+
 		// Spawn allows the mediator to spawn a new child navigation with the specified
 		// tree. This is used by the guardian to spawn a new child navigation when a
 		// new session is started or when a session is resumed, which allows the kernel
@@ -168,6 +180,12 @@ type (
 		// the structure of the file system and the options defined for the session.
 		Spawn(ctx context.Context, tree string) (*KernelResult, error)
 
+		// Sweep resumes a breadth first navigation from the frontier of nodes that
+		// were pending when the previous navigation was interrupted, which is
+		// used by the spawn resume strategy in place of spawning the following
+		// siblings of the fractured ancestors, in order to preserve level order.
+		Sweep(ctx context.Context, frontier []string) (*KernelResult, error)
+
 		// Bridge combines information gleaned from the previous traversal that was
 		// interrupted, into the resume traversal. This is used by the guardian to bridge
 		// the information from the previous traversal into the resume traversal, which
@@ -209,6 +227,26 @@ type (
 		// be used to allow users to define custom options that can be accessed
 		// and used by plugins and decorators during navigation.
 		Binder *opts.Binder
+
+		// Hibernation records the hibernation state of the navigation, which
+		// is maintained by the hibernation plugin, so that it can be saved
+		// for resume.
+		Hibernation *Hibernation
+	}
+
+	// Hibernation records the current hibernation state
+	Hibernation struct {
+		// State is the current hibernation state; undefined when
+		// hibernation is not active.
+		State enums.Hibernation
+
+		// Seen is the number of nodes seen, which counts towards the
+		// wake after nodes trigger.
+		Seen uint
+
+		// Invoked is the number of nodes invoked, which counts towards
+		// the sleep after invokes trigger.
+		Invoked uint
 	}
 
 	// Plugin used to define interaction with supplementary features
@@ -7,6 +7,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	lab "github.com/snivilised/jaywalk/test/laboratory"
@@ -47,7 +48,7 @@ type asserter struct {
 	result *enclave.KernelResult
 }
 
-func (a *asserter) equals(m enums.Metric, n uint) *asserter {
+func (a *asserter) equals(m enums.Metric, n core.MetricValue) *asserter {
 	Expect(a.result.Metrics().Count(
 		m,
 	)).To(BeEquivalentTo(n), fmt.Sprintf("💥 metric: '%v'", m))
@@ -60,3 +60,8 @@ func (r *KernelResult) Session() core.Session {
 func (r *KernelResult) Metrics() core.Reporter {
 	return r.reporter
 }
+
+// Timings returns the timings chronicle associated with the KernelResult.
+func (r *KernelResult) Timings() core.Chronicle {
+	return r.reporter
+}
@@ -25,6 +25,8 @@ var _ = Describe("KernelResult", func() {
 					enums.MetricNoFilesFilteredOut,
 					enums.MetricNoDirectoriesInvoked,
 					enums.MetricNoDirectoriesFilteredOut,
+					enums.MetricNoFileBytesInvoked,
+					enums.MetricLargestFileSize,
 				),
 			}
 			complete = false
@@ -65,6 +67,44 @@ var _ = Describe("KernelResult", func() {
 					)
 				},
 			}),
+			Entry(nil, &resultTE{
+				DescribedTE: lab.DescribedTE{
+					Given:  "byte metrics populated",
+					Should: "count beyond 32 bits",
+				},
+				arrange: func(trig *lab.Trigger) {
+					trig.Times(
+						enums.MetricNoFileBytesInvoked, 3<<30).Times(
+						enums.MetricNoFileBytesInvoked, 3<<30,
+					)
+				},
+				assert: func(a *asserter) {
+					a.equals(enums.MetricNoFileBytesInvoked, 6<<30)
+				},
+			}),
 		)
+
+		When("loaded from a previous session", func() {
+			It("🧪 should: accumulate totals and retain peaks", func() {
+				trig.Times(enums.MetricNoFileBytesInvoked, 100)
+				trig.Metrics[enums.MetricLargestFileSize].Peak(60)
+
+				previous := enclave.NewSupervisor().Many(
+					enums.MetricNoFileBytesInvoked,
+					enums.MetricLargestFileSize,
+				)
+				previous[enums.MetricNoFileBytesInvoked].Times(200)
+				previous[enums.MetricLargestFileSize].Peak(80)
+
+				reporter.Load(previous)
+
+				(&asserter{
+					result: enclave.NewResult(sess, reporter, complete),
+				}).equals(
+					enums.MetricNoFileBytesInvoked, 300).equals(
+					enums.MetricLargestFileSize, 80,
+				)
+			})
+		})
 	})
 })
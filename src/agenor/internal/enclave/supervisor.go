@@ -1,6 +1,8 @@
 package enclave
 
 import (
+	"sync"
+
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 )
@@ -14,6 +16,8 @@ type (
 	// after it is completed.
 	Supervisor struct {
 		metrics core.Metrics
+		timings core.Timings
+		mux     sync.Mutex
 	}
 
 	// Crate is a simple struct that contains a core.Metrics field. It is used to
@@ -38,6 +42,7 @@ type (
 func NewSupervisor() *Supervisor {
 	return &Supervisor{
 		metrics: make(core.Metrics),
+		timings: make(core.Timings),
 	}
 }
 
@@ -95,3 +100,34 @@ func (s *Supervisor) Count(mt enums.Metric) core.MetricValue {
 
 	return 0
 }
+
+// Timer returns the histogram for the given instrument, creating it if
+// it doesn't exist. This is used by the kernel to record the durations
+// of the operations being measured, when instrumentation is enabled.
+func (s *Supervisor) Timer(it enums.Instrument) *core.LatencyHistogram {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if _, exists := s.timings[it]; !exists {
+		s.timings[it] = &core.LatencyHistogram{
+			T: it,
+		}
+	}
+
+	return s.timings[it]
+}
+
+// Latency returns the histogram for the given instrument, or an empty
+// histogram if no durations have been recorded for it.
+func (s *Supervisor) Latency(it enums.Instrument) core.Histogram {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if histogram, exists := s.timings[it]; exists {
+		return histogram
+	}
+
+	return &core.LatencyHistogram{
+		T: it,
+	}
+}
@@ -57,6 +57,7 @@ func (p *plugin) Init(pi *enclave.PluginInit) error {
 	p.crate.Metrics = p.Mediator.Supervisor().Many(
 		enums.MetricNoDirectoriesFilteredOut,
 		enums.MetricNoFilesFilteredOut,
+		enums.MetricNoFileBytesFilteredOut,
 		enums.MetricNoChildFilesFound,
 		enums.MetricNoChildFilesFilteredOut,
 	)
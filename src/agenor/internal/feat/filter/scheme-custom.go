@@ -4,6 +4,7 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
 	"github.com/snivilised/jaywalk/src/third/lo"
 )
 
@@ -15,6 +16,15 @@ type customScheme struct {
 func (s *customScheme) create() error {
 	s.filter = s.o.Filter.Custom
 
+	if s.filter == nil && s.o.Filter.Named != nil {
+		filter, err := filtering.NewNamed(s.o.Filter.Named)
+		if err != nil {
+			return err
+		}
+
+		s.filter = filter
+	}
+
 	return s.filter.Validate()
 }
 
@@ -35,6 +45,12 @@ func matchNext(filter core.TraverseFilter,
 			enums.MetricNoFilesFilteredOut,
 		)
 		crate.Metrics[filteredOutMetric].Tick()
+
+		if !node.IsDirectory() && node.Info != nil {
+			crate.Metrics[enums.MetricNoFileBytesFilteredOut].Times(
+				core.MetricValue(node.Info.Size()), //nolint:gosec // ok
+			)
+		}
 	}
 
 	return matched, nil
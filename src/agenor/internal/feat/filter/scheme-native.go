@@ -18,6 +18,10 @@ func (s *nativeScheme) create() error {
 		return err
 	}
 
+	if err := filtering.Traceable(filter, s.o.Behaviours.Order); err != nil {
+		return err
+	}
+
 	s.filter = filter
 
 	if s.o.Filter.Sink != nil {
@@ -29,6 +33,14 @@ func (s *nativeScheme) create() error {
 	return nil
 }
 
+func (s *nativeScheme) init(pi *enclave.PluginInit, crate *enclave.Crate) {
+	s.common.init(pi, crate)
+
+	if tracker, ok := s.filter.(filtering.Tracker); ok {
+		tracker.Track(pi.Resources.Forest, pi.Controls)
+	}
+}
+
 func (s *nativeScheme) next(servant core.Servant,
 	_ enclave.Inspection,
 ) (bool, error) {
@@ -28,8 +28,12 @@ func (s *samplerScheme) create() error {
 	// to core.Node) on behalf of the sampler.
 	s.o.Hooks.ReadDirectory.Chain(
 		func(result []fs.DirEntry, err error,
-			_ fs.ReadDirFS, _ string,
+			_ fs.ReadDirFS, parent string,
 		) ([]fs.DirEntry, error) {
+			if sensitive, ok := s.filter.(filtering.DirectorySensitive); ok {
+				return sensitive.MatchingWithin(parent, result), err
+			}
+
 			return s.filter.Matching(result), err
 		},
 	)
@@ -19,12 +19,12 @@ type (
 	hibernateStates map[enums.Hibernation]state
 
 	triggers struct {
-		wake  core.TraverseFilter
-		sleep core.TraverseFilter
+		wake  condition
+		sleep condition
 	}
 
 	profile interface {
-		init(controls *life.Controls) error
+		init(controls *life.Controls, status *enclave.Hibernation) error
 		next(servant core.Servant, node *core.Node,
 			inspection enclave.Inspection,
 		) (bool, error)
@@ -35,13 +35,52 @@ type (
 		fo       *pref.FilterOptions
 		triggers triggers
 		controls *life.Controls
+		states   hibernateStates
+		status   *enclave.Hibernation
 	}
 )
 
 func launch(ho *core.HibernateOptions) enums.Hibernation {
-	if ho.WakeAt != nil {
+	if ho.WakeAt != nil || ho.ToggleAt != nil || ho.Triggers.IsWakeActive() {
 		return enums.HibernationPending
 	}
 
 	return enums.HibernationActive
 }
+
+// start binds the profile to status, which also holds the counts of
+// nodes seen and invoked, so that these are saved with the navigation
+// state and restored from it on resume.
+func (c *common) start(controls *life.Controls, status *enclave.Hibernation) {
+	c.controls = controls
+	c.status = status
+	c.transition(launch(c.ho))
+}
+
+// transition records the new hibernation state, so that it is
+// included in the navigation state when saved.
+func (c *common) transition(en enums.Hibernation) {
+	c.status.State = en
+}
+
+// next invokes the current state, which may have been restored from a
+// previous session on resume; a state not recognised by the profile
+// results in the launch state.
+func (c *common) next(servant core.Servant, node *core.Node,
+	inspection enclave.Inspection,
+) (bool, error) {
+	current, found := c.states[c.status.State]
+	if !found {
+		c.transition(launch(c.ho))
+		current = c.states[c.status.State]
+	}
+
+	invoke, err := current.next(servant, node, inspection)
+
+	c.status.Seen++
+	if invoke {
+		c.status.Invoked++
+	}
+
+	return invoke, err
+}
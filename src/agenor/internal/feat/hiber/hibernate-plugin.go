@@ -6,26 +6,30 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	"github.com/snivilised/jaywalk/src/agenor/internal/kernel"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/third/lo"
 )
 
 // IfActive returns a new plugin if the hibernate feature is active, otherwise nil.
 func IfActive(o *pref.Options, _ enums.Subscription, mediator enclave.Mediator) enclave.Plugin {
-	if o.Hibernate.IsHibernateActive() {
-		return &plugin{
-			BasePlugin: kernel.BasePlugin{
-				Mediator:      mediator,
-				ActivatedRole: enums.RoleHibernate,
-			},
-			profile: &simple{
-				common: common{
-					ho: &o.Hibernate,
-					fo: &o.Filter,
-				},
-			},
-		}
+	if !o.Hibernate.IsHibernateActive() {
+		return nil
 	}
 
-	return nil
+	base := common{
+		ho: &o.Hibernate,
+		fo: &o.Filter,
+	}
+
+	return &plugin{
+		BasePlugin: kernel.BasePlugin{
+			Mediator:      mediator,
+			ActivatedRole: enums.RoleHibernate,
+		},
+		profile: lo.Ternary[profile](o.Hibernate.ToggleAt != nil,
+			&toggle{common: base},
+			&simple{common: base},
+		),
+	}
 }
 
 type plugin struct {
@@ -43,7 +47,7 @@ func (p *plugin) Next(servant core.Servant,
 
 // Init initializes the plugin, setting up the profile and decorating the plugin.
 func (p *plugin) Init(pi *enclave.PluginInit) error {
-	if err := p.profile.init(pi.Controls); err != nil {
+	if err := p.profile.init(pi.Controls, pi.Resources.Hibernation); err != nil {
 		return err
 	}
 
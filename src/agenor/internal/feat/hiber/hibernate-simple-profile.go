@@ -14,59 +14,42 @@ import (
 
 type simple struct {
 	common
-	states  hibernateStates
-	current state
 }
 
-func (p *simple) init(controls *life.Controls) error {
+func (p *simple) init(controls *life.Controls, status *enclave.Hibernation) error {
 	p.states = p.create()
-	p.controls = controls
-
-	if p.ho.WakeAt != nil {
-		filter, err := filtering.New(p.ho.WakeAt, p.fo)
-		if err != nil {
-			return err
-		}
-
-		p.triggers.wake = filter
-
-		if p.triggers.sleep == nil {
-			p.triggers.sleep = filtering.NewProhibitiveTraverseFilter(
-				&core.FilterDef{
-					Description: li18ngo.Render(locale.ProhibitiveTemplData{}),
-				},
-			)
-		}
+
+	wake, err := p.conditions(p.ho.WakeAt, p.wakeTriggers())
+	if err != nil {
+		return err
 	}
 
-	if p.ho.SleepAt != nil {
-		filter, err := filtering.New(p.ho.SleepAt, p.fo)
-		if err != nil {
-			return err
-		}
-
-		p.triggers.sleep = filter
-
-		if p.triggers.wake == nil {
-			p.triggers.wake = filtering.NewPermissiveTraverseFilter(
-				&core.FilterDef{
-					Description: li18ngo.Render(locale.PermissiveTemplData{}),
-				},
-			)
-		}
+	sleep, err := p.conditions(p.ho.SleepAt, p.sleepTriggers())
+	if err != nil {
+		return err
 	}
 
-	p.transition(launch(p.ho))
+	if len(wake) > 0 && len(sleep) == 0 {
+		sleep = append(sleep, filtering.NewProhibitiveTraverseFilter(
+			&core.FilterDef{
+				Description: li18ngo.Render(locale.ProhibitiveTemplData{}),
+			},
+		))
+	}
 
-	return nil
-}
+	if len(sleep) > 0 && len(wake) == 0 {
+		wake = append(wake, filtering.NewPermissiveTraverseFilter(
+			&core.FilterDef{
+				Description: li18ngo.Render(locale.PermissiveTemplData{}),
+			},
+		))
+	}
 
-func (p *simple) transition(en enums.Hibernation) {
-	p.current = p.states[en]
-}
+	p.triggers.wake = compose(wake)
+	p.triggers.sleep = compose(sleep)
+	p.start(controls, status)
 
-func (p *simple) next(servant core.Servant, node *core.Node, inspection enclave.Inspection) (bool, error) {
-	return p.current.next(servant, node, inspection)
+	return nil
 }
 
 func (p *simple) create() hibernateStates {
@@ -0,0 +1,61 @@
+package hiber
+
+import (
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/agenor/life"
+)
+
+// toggle is the profile in which each node matching the toggle filter
+// flips hibernation between dormant and active, so that multiple disjoint
+// regions of the tree can be processed in a single navigation.
+type toggle struct {
+	common
+}
+
+func (p *toggle) init(controls *life.Controls, status *enclave.Hibernation) error {
+	p.states = p.create()
+
+	filter, err := filtering.New(p.ho.ToggleAt, p.fo)
+	if err != nil {
+		return err
+	}
+
+	p.triggers.wake = filter
+	p.triggers.sleep = filter
+	p.start(controls, status)
+
+	return nil
+}
+
+func (p *toggle) create() hibernateStates {
+	return hibernateStates{
+		enums.HibernationPending: state{
+			next: func(_ core.Servant, node *core.Node, _ enclave.Inspection) (bool, error) {
+				if p.triggers.wake.IsMatch(node) {
+					p.controls.Wake.Dispatch()(p.triggers.wake.Description())
+					p.transition(enums.HibernationActive)
+
+					return p.ho.Behaviour.InclusiveWake, nil
+				}
+
+				return false, nil
+			},
+		},
+
+		enums.HibernationActive: state{
+			next: func(_ core.Servant, node *core.Node, _ enclave.Inspection) (bool, error) {
+				if p.triggers.sleep.IsMatch(node) {
+					p.controls.Sleep.Dispatch()(p.triggers.sleep.Description())
+					p.transition(enums.HibernationPending)
+
+					return p.ho.Behaviour.InclusiveSleep, nil
+				}
+
+				return true, nil
+			},
+		},
+	}
+}
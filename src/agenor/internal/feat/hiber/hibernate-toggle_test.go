@@ -0,0 +1,155 @@
+package hiber_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+)
+
+var _ = Describe("toggle", Ordered, func() {
+	var (
+		fS *luna.MemFS
+		rS agenor.TraversalFS
+	)
+
+	BeforeAll(func() {
+		const (
+			verbose = false
+		)
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		rS = tfs.New()
+
+		Expect(li18ngo.Register()).To(Succeed())
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	forest := func(_ string) *core.Forest {
+		return &core.Forest{
+			T: fS,
+			R: rS,
+		}
+	}
+
+	toggle := agenor.WithHibernationFilterToggle(&core.FilterDef{
+		Type:        enums.FilterTypeRegex,
+		Description: "Toggle At: Chromatics|College|Teenage Color",
+		Pattern:     "^(Chromatics|College|Teenage Color)$",
+	})
+
+	Context("comprehension", func() {
+		It("🧪 should: wake and sleep at each toggle", func(ctx SpecContext) {
+			var wakes, sleeps []string
+
+			_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Tree:         lab.Static.RetroWave,
+					Subscription: enums.SubscribeDirectories,
+					Head: pref.Head{
+						Handler: func(_ agenor.Servant) error {
+							return nil
+						},
+						GetForest: forest,
+					},
+				},
+				toggle,
+				agenor.WithOnWake(func(description string) {
+					wakes = append(wakes, description)
+				}),
+				agenor.WithOnSleep(func(description string) {
+					sleeps = append(sleeps, description)
+				}),
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(wakes).To(HaveLen(2))
+			Expect(sleeps).To(HaveLen(1))
+		})
+	})
+
+	When("resumed", func() {
+		It("🧪 should: continue in saved hibernation state", func(ctx SpecContext) {
+			admin := GinkgoT().TempDir()
+			visited := map[string]int{}
+			interrupted, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Tree:         lab.Static.RetroWave,
+					Subscription: enums.SubscribeDirectories,
+					Head: pref.Head{
+						Handler: func(servant agenor.Servant) error {
+							node := servant.Node()
+							visited[node.Extension.Name]++
+
+							if node.Extension.Name == "Chromatics" {
+								cancel()
+							}
+
+							return nil
+						},
+						GetForest: forest,
+					},
+				},
+				toggle,
+				agenor.WithBreadthFirst(),
+				agenor.WithAdminPath(admin),
+			)).Navigate(interrupted)
+
+			var saved *locale.TraversalSavedError
+			Expect(errors.As(err, &saved)).To(BeTrue(), "error should carry the saved path")
+
+			loaded, err := persist.Unmarshal(&persist.UnmarshalRequest{
+				Restore: &enclave.RestoreState{
+					Path: saved.SavedTo,
+					FS:   rS,
+				},
+			})
+			Expect(err).To(Succeed())
+			Expect(loaded.Active.Hibernation).To(Equal(enums.HibernationActive))
+
+			_, err = agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(servant agenor.Servant) error {
+							visited[servant.Node().Extension.Name]++
+
+							return nil
+						},
+						GetForest: forest,
+					},
+					From:     saved.SavedTo,
+					Strategy: enums.ResumeStrategySpawn,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(visited).To(Equal(map[string]int{
+				"Chromatics":    1,
+				"Teenage Color": 1,
+				"Innerworld":    1,
+			}))
+		})
+	})
+})
@@ -0,0 +1,194 @@
+package hiber
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/li18ngo"
+)
+
+type (
+	// condition is met by the node at which a hibernation transition
+	// occurs; a filter is a condition.
+	condition interface {
+		IsMatch(node *core.Node) bool
+		Description() string
+	}
+
+	// anyCondition is met when any of its conditions is met, so that
+	// filters and triggers can be composed.
+	anyCondition struct {
+		conditions []condition
+		met        condition
+	}
+
+	// limitCondition is met once the count has reached the limit
+	limitCondition struct {
+		description string
+		limit       uint
+		count       func() uint
+	}
+
+	// depthCondition is met by a node at the depth
+	depthCondition struct {
+		description string
+		depth       uint
+	}
+
+	// budgetCondition is met once the budget has elapsed since began
+	budgetCondition struct {
+		description string
+		budget      time.Duration
+		began       time.Time
+	}
+)
+
+func (c *anyCondition) IsMatch(node *core.Node) bool {
+	for _, cond := range c.conditions {
+		if cond.IsMatch(node) {
+			c.met = cond
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Description returns the description of the condition that was met,
+// otherwise the descriptions of all the conditions.
+func (c *anyCondition) Description() string {
+	if c.met != nil {
+		return c.met.Description()
+	}
+
+	descriptions := make([]string, 0, len(c.conditions))
+	for _, cond := range c.conditions {
+		descriptions = append(descriptions, cond.Description())
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+func (c *limitCondition) IsMatch(_ *core.Node) bool {
+	return c.count() >= c.limit
+}
+
+func (c *limitCondition) Description() string {
+	return c.description
+}
+
+func (c *depthCondition) IsMatch(node *core.Node) bool {
+	return node.Extension.Depth == core.TraversalDepth(c.depth)
+}
+
+func (c *depthCondition) Description() string {
+	return c.description
+}
+
+func (c *budgetCondition) IsMatch(_ *core.Node) bool {
+	return time.Since(c.began) >= c.budget
+}
+
+func (c *budgetCondition) Description() string {
+	return c.description
+}
+
+func describe(trigger string, limit any) string {
+	return li18ngo.Render(locale.NewHibernationTriggerTemplData(
+		trigger, fmt.Sprintf("%v", limit),
+	))
+}
+
+// compose combines the conditions into a single condition; nil
+// when there are none.
+func compose(conditions []condition) condition {
+	switch len(conditions) {
+	case 0:
+		return nil
+	case 1:
+		return conditions[0]
+	}
+
+	return &anyCondition{
+		conditions: conditions,
+	}
+}
+
+// conditions creates the filter, if defined, followed by the triggers.
+func (c *common) conditions(def *core.FilterDef,
+	triggers []condition,
+) ([]condition, error) {
+	result := []condition{}
+
+	if def != nil {
+		filter, err := filtering.New(def, c.fo)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, filter)
+	}
+
+	return append(result, triggers...), nil
+}
+
+func (c *common) wakeTriggers() []condition {
+	t := &c.ho.Triggers
+	result := []condition{}
+
+	if t.WakeAfterNodes > 0 {
+		result = append(result, &limitCondition{
+			description: describe("wake-after-nodes", t.WakeAfterNodes),
+			limit:       t.WakeAfterNodes,
+			count: func() uint {
+				return c.status.Seen
+			},
+		})
+	}
+
+	if t.WakeAtDepth > 0 {
+		result = append(result, &depthCondition{
+			description: describe("wake-at-depth", t.WakeAtDepth),
+			depth:       t.WakeAtDepth,
+		})
+	}
+
+	return result
+}
+
+func (c *common) sleepTriggers() []condition {
+	t := &c.ho.Triggers
+	result := []condition{}
+
+	if t.SleepAfterInvokes > 0 {
+		result = append(result, &limitCondition{
+			description: describe("sleep-after-invokes", t.SleepAfterInvokes),
+			limit:       t.SleepAfterInvokes,
+			count: func() uint {
+				return c.status.Invoked
+			},
+		})
+	}
+
+	if t.SleepAtDepth > 0 {
+		result = append(result, &depthCondition{
+			description: describe("sleep-at-depth", t.SleepAtDepth),
+			depth:       t.SleepAtDepth,
+		})
+	}
+
+	if t.SleepAfter > 0 {
+		result = append(result, &budgetCondition{
+			description: describe("sleep-after", t.SleepAfter),
+			budget:      t.SleepAfter,
+			began:       time.Now(),
+		})
+	}
+
+	return result
+}
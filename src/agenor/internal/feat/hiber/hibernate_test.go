@@ -2,6 +2,7 @@ package hiber_test
 
 import (
 	"regexp/syntax"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -115,6 +116,65 @@ var _ = Describe("feature", Ordered, func() {
 		})
 	})
 
+	When("retired", func() {
+		It("🧪 should: end traversal without descending any further", func(ctx SpecContext) {
+			var (
+				asleep    bool
+				descended []string
+			)
+
+			_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Subscription: enums.SubscribeDirectories,
+					Head: pref.Head{
+						Handler: func(_ agenor.Servant) error {
+							return nil
+						},
+						GetForest: func(_ string) *core.Forest {
+							return &core.Forest{
+								T: fS,
+								R: tfs.New(),
+							}
+						},
+					},
+					Tree: lab.Static.RetroWave,
+				},
+				agenor.WithOnSleep(func(_ string) {
+					asleep = true
+				}),
+				agenor.WithOnDescend(func(node *core.Node) {
+					if asleep {
+						descended = append(descended, node.Path)
+					}
+				}),
+				agenor.WithHibernationOptions(
+					&core.HibernateOptions{
+						WakeAt: &core.FilterDef{
+							Type:        enums.FilterTypeGlob,
+							Description: "Wake At: Night Drive",
+							Pattern:     "Night Drive",
+						},
+						SleepAt: &core.FilterDef{
+							Type:        enums.FilterTypeGlob,
+							Description: "Sleep At: College",
+							Pattern:     "College",
+						},
+					},
+				),
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(asleep).To(BeTrue())
+
+			// the first node encountered once retired, is descended before
+			// it is found that the traversal is over
+			//
+			Expect(len(descended)).To(BeNumerically("<=", 1),
+				"descended: %v", descended,
+			)
+		})
+	})
+
 	DescribeTable("simple hibernate",
 		func(ctx SpecContext, entry *lab.HibernateTE) {
 			recall := make(lab.Recall)
@@ -170,6 +230,8 @@ var _ = Describe("feature", Ordered, func() {
 					&core.HibernateOptions{
 						WakeAt:    entry.Hibernate.WakeAt,
 						SleepAt:   entry.Hibernate.SleepAt,
+						ToggleAt:  entry.Hibernate.ToggleAt,
+						Triggers:  entry.Hibernate.Triggers,
 						Behaviour: entry.Hibernate.Behaviour,
 					},
 				),
@@ -350,6 +412,182 @@ var _ = Describe("feature", Ordered, func() {
 			},
 		}),
 
+		// === toggle ========================================================
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "toggle (directories, inclusive:default)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Mandatory: []string{"Chromatics", "Night Drive",
+					"Teenage Color", "Electric Youth", "Innerworld",
+				},
+				Prohibited: []string{lab.Static.RetroWave, "College",
+					"Northern Council",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 5,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				ToggleAt: &core.FilterDef{
+					Type:        enums.FilterTypeRegex,
+					Description: "Toggle At: Chromatics|College|Teenage Color",
+					Pattern:     "^(Chromatics|College|Teenage Color)$",
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  true,
+					InclusiveSleep: false,
+				},
+			},
+		}),
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "toggle (directories, exclusive wake, inclusive sleep)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Mandatory: []string{"Night Drive", "College",
+					"Electric Youth", "Innerworld",
+				},
+				Prohibited: []string{lab.Static.RetroWave, "Chromatics",
+					"Northern Council", "Teenage Color",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 4,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				ToggleAt: &core.FilterDef{
+					Type:        enums.FilterTypeRegex,
+					Description: "Toggle At: Chromatics|College|Teenage Color",
+					Pattern:     "^(Chromatics|College|Teenage Color)$",
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  false,
+					InclusiveSleep: true,
+				},
+			},
+		}),
+
+		// === triggers ======================================================
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "wake after nodes and sleep after invokes (directories)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Mandatory:    []string{"College", "Northern Council"},
+				Prohibited: []string{lab.Static.RetroWave, "Chromatics",
+					"Night Drive", "Teenage Color", "Electric Youth", "Innerworld",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 2,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				Triggers: core.HibernateTriggers{
+					WakeAfterNodes:    3,
+					SleepAfterInvokes: 2,
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  true,
+					InclusiveSleep: false,
+				},
+			},
+		}),
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "wake at depth and sleep at depth (directories)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Mandatory:    []string{"Night Drive"},
+				Prohibited: []string{lab.Static.RetroWave, "Chromatics",
+					"College", "Northern Council", "Teenage Color",
+					"Electric Youth", "Innerworld",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 1,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				Triggers: core.HibernateTriggers{
+					WakeAtDepth:  2,
+					SleepAtDepth: 1,
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  true,
+					InclusiveSleep: false,
+				},
+			},
+		}),
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "wake filter composed with wake at depth (directories)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Mandatory: []string{"Night Drive", "College", "Northern Council",
+					"Teenage Color", "Electric Youth", "Innerworld",
+				},
+				Prohibited: []string{lab.Static.RetroWave, "Chromatics"},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 6,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				WakeAt: &core.FilterDef{
+					Type:        enums.FilterTypeGlob,
+					Description: "Wake At: College",
+					Pattern:     "College",
+				},
+				Triggers: core.HibernateTriggers{
+					WakeAtDepth: 2,
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  true,
+					InclusiveSleep: false,
+				},
+			},
+		}),
+
+		Entry(nil, &lab.HibernateTE{
+			DescribedTE: lab.DescribedTE{
+				Given: "sleep after elapsed budget (directories)",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Prohibited: []string{lab.Static.RetroWave, "Chromatics",
+					"Night Drive", "College", "Northern Council", "Teenage Color",
+					"Electric Youth", "Innerworld",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Directories: 0,
+				},
+			},
+			Hibernate: &core.HibernateOptions{
+				Triggers: core.HibernateTriggers{
+					SleepAfter: time.Nanosecond,
+				},
+				Behaviour: core.HibernationBehaviour{
+					InclusiveWake:  true,
+					InclusiveSleep: false,
+				},
+			},
+		}),
+
 		// error ==================================================================
 
 		Entry(nil, &lab.HibernateTE{
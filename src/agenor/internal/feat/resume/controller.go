@@ -16,6 +16,7 @@ type Controller struct {
 	med      enclave.Mediator
 	relic    *pref.Relic
 	load     *opts.LoadInfo
+	forest   *core.Forest
 	strategy Strategy
 }
 
@@ -47,6 +48,12 @@ func (c *Controller) Bye(result core.TraverseResult) {
 	c.med.Bye(result)
 }
 
+// Conclude is called once all the navigations required by the resume
+// have finished.
+func (c *Controller) Conclude(err error) {
+	c.med.Conclude(err)
+}
+
 func newStrategy(inception *kernel.Inception,
 	sealer enclave.GuardianSealer,
 	mediator enclave.Mediator,
@@ -82,6 +89,12 @@ func newStrategy(inception *kernel.Inception,
 
 // Navigate navigates the tree within the context of resume.
 func (c *Controller) Navigate(ctx context.Context) (*enclave.KernelResult, error) {
+	if err := verify(c.load.State, c.relic.Drift,
+		c.med, c.forest.T.Calc(), c.load.O.Monitor.Log,
+	); err != nil {
+		return c.Result(ctx), err
+	}
+
 	if err := c.strategy.init(c.load); err != nil {
 		return c.Result(ctx), err
 	}
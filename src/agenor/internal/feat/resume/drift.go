@@ -0,0 +1,128 @@
+package resume
+
+import (
+	"log/slog"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/kernel"
+	"github.com/snivilised/jaywalk/src/locale"
+	nef "github.com/snivilised/nefilim"
+)
+
+const (
+	driftMissing  = "missing"
+	driftModified = "modified"
+)
+
+// verify determines whether the tree has drifted since the navigation state
+// was saved, by comparing the ancestry recorded in the active state against
+// the tree as it is now. How drift is handled depends upon the policy; when
+// resuming from the nearest ancestor, the active state is rewound to the
+// shallowest directory that has drifted, or its parent if it no longer exists.
+// The tree is surveyed via the same hooks by which the ancestry was recorded.
+// State saved without an ancestry is not verified.
+func verify(active *core.ActiveState,
+	policy enums.DriftPolicy,
+	surveyor kernel.Surveyor,
+	calc nef.PathCalc,
+	log *slog.Logger,
+) error {
+	index, reason := drifted(active.Ancestry, surveyor)
+	if index < 0 {
+		return nil
+	}
+
+	err := locale.NewTreeDriftError(active.Ancestry[index].Path, reason)
+
+	switch policy {
+	case enums.DriftFail:
+		return err
+
+	case enums.DriftNearest:
+		if reason == driftMissing {
+			if index == 0 {
+				return err
+			}
+
+			index--
+		}
+
+		rewind(active, active.Ancestry[index].Path, calc)
+
+	case enums.DriftUndefined, enums.DriftWarn:
+	}
+
+	log.Warn(err.Error())
+
+	return nil
+}
+
+// drifted returns the index of the shallowest landmark that no longer
+// matches the tree, along with the reason, or -1 if there is no drift.
+func drifted(ancestry []core.Landmark, surveyor kernel.Surveyor) (int, string) {
+	for i, landmark := range ancestry {
+		survey, err := kernel.Survey(surveyor, landmark.Path)
+		if err != nil {
+			return i, driftMissing
+		}
+
+		if !survey.Modified.Equal(landmark.Modified) || survey.Entries != landmark.Entries {
+			return i, driftModified
+		}
+	}
+
+	return -1, ""
+}
+
+// rewind moves the point of resumption up to the ancestor directory, so that
+// it is navigated again in its entirety. Pending nodes of a breadth first
+// frontier that are within the ancestor are discarded, as these will be
+// re-discovered.
+func rewind(active *core.ActiveState, ancestor string, calc nef.PathCalc) {
+	for path := active.CurrentPath; path != ancestor; {
+		parent := calc.Dir(path)
+		if parent == path {
+			break
+		}
+
+		path = parent
+
+		if active.Depth > 0 {
+			active.Depth--
+		}
+	}
+
+	if len(active.Frontier) > 0 {
+		frontier := []string{ancestor}
+
+		for _, path := range active.Frontier {
+			if !within(path, ancestor, calc) {
+				frontier = append(frontier, path)
+			}
+		}
+
+		active.Frontier = frontier
+	}
+
+	active.CurrentPath = ancestor
+	active.IsDir = true
+}
+
+// within determines whether path is the ancestor or one of its descendants.
+func within(path, ancestor string, calc nef.PathCalc) bool {
+	for len(path) >= len(ancestor) {
+		if path == ancestor {
+			return true
+		}
+
+		parent := calc.Dir(path)
+		if parent == path {
+			break
+		}
+
+		path = parent
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package resume_test
+
+import (
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Resume breadth first", Ordered, func() {
+	var (
+		from         string
+		fS           *luna.MemFS
+		teenageColor string
+		innerworld   string
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		from = lab.GetJSONPath()
+		teenageColor = filepath.Join(lab.Static.RetroWave, "College", lab.Static.TeenageColor)
+		innerworld = filepath.Join(lab.Static.RetroWave, lab.Static.ElectricYouth, "Innerworld")
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	When("spawn with frontier", func() {
+		It("🧪 should: continue in level order from the frontier", func(ctx SpecContext) {
+			visited := []*core.Node{}
+
+			_, err := agenor.Walk().Configure(enclave.Loader(func(active *core.ActiveState) {
+				active.Tree = lab.Static.RetroWave
+				active.TraverseDescription.IsRelative = true
+				active.ResumeDescription.IsRelative = false
+				active.Subscription = enums.SubscribeUniversal
+				active.CurrentPath = teenageColor
+				active.Depth = 2
+				active.Frontier = []string{teenageColor, innerworld}
+			})).Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(servant agenor.Servant) error {
+							visited = append(visited, servant.Node())
+
+							return nil
+						},
+						GetForest: func(_ string) *core.Forest {
+							return &core.Forest{
+								T: fS,
+								R: tfs.New(),
+							}
+						},
+					},
+					From:     from,
+					Strategy: enums.ResumeStrategySpawn,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(len(visited)).To(BeNumerically(">", 2))
+			Expect(visited[0].Path).To(Equal(teenageColor))
+			Expect(visited[1].Path).To(Equal(innerworld))
+
+			for _, node := range visited {
+				Expect(node.Path).To(Or(
+					HavePrefix(teenageColor), HavePrefix(innerworld),
+				))
+				Expect(int(node.VisualDepth())).To(Equal(
+					len(strings.Split(node.Path, string(filepath.Separator)))-1,
+				), "node: '%v' has incorrect depth", node.Path)
+			}
+
+			Expect(visited[0].Extension.Scope & (enums.ScopeTree | enums.ScopeTop)).To(
+				BeZero(),
+			)
+		})
+	})
+})
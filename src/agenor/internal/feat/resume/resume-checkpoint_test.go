@@ -0,0 +1,279 @@
+package resume_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Resume from checkpoint", Ordered, func() {
+	var (
+		fS         *luna.MemFS
+		rS         agenor.TraversalFS
+		admin      string
+		directory  string
+		errStopped = errors.New("stopped")
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		rS = tfs.New()
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+
+		// the resume file is loaded from the native file system
+		//
+		admin = GinkgoT().TempDir()
+		directory = filepath.Join(admin, core.ResumeTail)
+	})
+
+	forest := func(_ string) *core.Forest {
+		return &core.Forest{
+			T: fS,
+			R: rS,
+		}
+	}
+
+	checkpoints := func() []string {
+		entries, err := os.ReadDir(directory)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		Expect(err).To(Succeed())
+
+		paths := []string{}
+
+		for _, entry := range entries {
+			paths = append(paths, filepath.Join(directory, entry.Name()))
+		}
+
+		return paths
+	}
+
+	prime := func(ctx SpecContext, handler core.Client,
+		settings ...pref.Option,
+	) (core.TraverseResult, error) {
+		return agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler:   handler,
+					GetForest: forest,
+				},
+			},
+			append([]pref.Option{
+				pref.WithAdminPath(admin),
+				pref.WithCheckpoint(&pref.CheckpointOptions{
+					Nodes: 1,
+				}),
+			}, settings...)...,
+		)).Navigate(ctx)
+	}
+
+	When("navigation completes successfully", func() {
+		It("🧪 should: remove checkpoints", func(ctx SpecContext) {
+			_, err := prime(ctx, func(_ core.Servant) error {
+				return nil
+			})
+
+			Expect(err).To(Succeed())
+			Expect(checkpoints()).To(BeEmpty())
+		})
+	})
+
+	When("navigation is terminated", func() {
+		It("🧪 should: resume from checkpoint without missing any node", func(ctx SpecContext) {
+			everything := []string{}
+			_, err := prime(ctx, func(servant core.Servant) error {
+				everything = append(everything, servant.Node().Path)
+
+				return nil
+			})
+			Expect(err).To(Succeed())
+
+			visited := map[string]int{}
+			_, err = prime(ctx, func(servant core.Servant) error {
+				node := servant.Node()
+				if node.Extension.Name == lab.Static.TeenageColor {
+					return errStopped
+				}
+
+				visited[node.Path]++
+
+				return nil
+			})
+			Expect(err).To(MatchError(errStopped))
+
+			saved := checkpoints()
+			Expect(saved).To(HaveLen(1), "only the latest checkpoint should be retained")
+
+			_, err = agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(servant core.Servant) error {
+							visited[servant.Node().Path]++
+
+							return nil
+						},
+						GetForest: forest,
+					},
+					From:     saved[0],
+					Strategy: enums.ResumeStrategyFastward,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+
+			for _, path := range everything {
+				Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+			}
+		})
+
+		It("🧪 should: resume from latest checkpoint for tree", func(ctx SpecContext) {
+			_, err := prime(ctx, func(servant core.Servant) error {
+				if servant.Node().Extension.Name == lab.Static.TeenageColor {
+					return errStopped
+				}
+
+				return nil
+			})
+			Expect(err).To(MatchError(errStopped))
+
+			catalogue := agenor.Checkpoints(rS, admin)
+			checkpoints, err := catalogue.List()
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(HaveLen(1))
+
+			detail, err := catalogue.Inspect(checkpoints[0].Path)
+			Expect(err).To(Succeed())
+			Expect(detail.Tree).To(Equal(lab.Static.RetroWave))
+			Expect(detail.Subscription).To(Equal(enums.SubscribeUniversal))
+
+			invoked := 0
+			_, err = agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(_ core.Servant) error {
+							invoked++
+
+							return nil
+						},
+						GetForest: forest,
+					},
+					Latest:   lab.Static.RetroWave,
+					Admin:    admin,
+					Strategy: enums.ResumeStrategyFastward,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(invoked).To(BeNumerically(">", 0))
+		})
+	})
+
+	When("hibernation triggers are counting", func() {
+		It("🧪 should: fire trigger at the same node after resume", func(ctx SpecContext) {
+			const (
+				invokes = 8
+				stopAt  = 4
+			)
+
+			triggers := pref.WithHibernationTriggers(&core.HibernateTriggers{
+				SleepAfterInvokes: invokes,
+			})
+
+			expected := []string{}
+			_, err := prime(ctx, func(servant core.Servant) error {
+				expected = append(expected, servant.Node().Path)
+
+				return nil
+			}, triggers)
+			Expect(err).To(Succeed())
+			Expect(expected).To(HaveLen(invokes))
+
+			invoked := 0
+			_, err = prime(ctx, func(_ core.Servant) error {
+				if invoked++; invoked == stopAt {
+					return errStopped
+				}
+
+				return nil
+			}, triggers)
+			Expect(err).To(MatchError(errStopped))
+
+			saved := checkpoints()
+			Expect(saved).To(HaveLen(1))
+
+			resumed := []string{}
+			_, err = agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(servant core.Servant) error {
+							resumed = append(resumed, servant.Node().Path)
+
+							return nil
+						},
+						GetForest: forest,
+					},
+					From:     saved[0],
+					Strategy: enums.ResumeStrategySpawn,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(resumed).NotTo(BeEmpty())
+			Expect(resumed[len(resumed)-1]).To(Equal(expected[invokes-1]),
+				"sleep after invokes trigger should fire at the same node",
+			)
+		})
+	})
+
+	When("no checkpoint saved for tree", func() {
+		It("🧪 should: fail to resume from latest", func(ctx SpecContext) {
+			_, err := agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(_ core.Servant) error {
+							return nil
+						},
+						GetForest: forest,
+					},
+					Latest:   lab.Static.RetroWave,
+					Admin:    admin,
+					Strategy: enums.ResumeStrategyFastward,
+				},
+			)).Navigate(ctx)
+
+			var notFound *locale.ResumeFileNotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,233 @@
+package resume_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Resume after tree drift", Ordered, func() {
+	var (
+		fS      *luna.MemFS
+		rS      agenor.TraversalFS
+		college string
+		bonus   string
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		rS = tfs.New()
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		college = fS.Calc().Join(lab.Static.RetroWave, "College")
+		bonus = fS.Calc().Join(college, "bonus.flac")
+	})
+
+	forest := func(_ string) *core.Forest {
+		return &core.Forest{
+			T: fS,
+			R: rS,
+		}
+	}
+
+	// hidden is a read directory hook that hides the bonus track
+	hidden := func() pref.Option {
+		return pref.WithHookReadDirectory(
+			func(rsys fs.ReadDirFS, dirname string) ([]fs.DirEntry, error) {
+				entries, err := pref.DefaultReadEntriesHook(rsys, dirname)
+
+				return lo.Reject(entries, func(entry fs.DirEntry, _ int) bool {
+					return entry.Name() == "bonus.flac"
+				}), err
+			},
+		)
+	}
+
+	prime := func(ctx context.Context, admin string, handler core.Client,
+		settings ...pref.Option,
+	) error {
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler:   handler,
+					GetForest: forest,
+				},
+			},
+			append(settings, pref.WithAdminPath(admin))...,
+		)).Navigate(ctx)
+
+		return err
+	}
+
+	// interrupt primes a navigation that is cancelled within the College
+	// directory and returns the path of the resume file that was saved.
+	interrupt := func(ctx context.Context, admin string, visited map[string]int,
+		settings ...pref.Option,
+	) string {
+		interrupted, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		err := prime(interrupted, admin, func(servant core.Servant) error {
+			node := servant.Node()
+			visited[node.Path]++
+
+			if node.Extension.Name == lab.Static.TeenageColor {
+				cancel()
+			}
+
+			return nil
+		}, settings...)
+
+		var saved *locale.TraversalSavedError
+		Expect(errors.As(err, &saved)).To(BeTrue(), "error should carry the saved path")
+
+		return saved.SavedTo
+	}
+
+	resume := func(ctx context.Context, from string, policy enums.DriftPolicy,
+		visited map[string]int, settings ...pref.Option,
+	) error {
+		_, err := agenor.Walk().Configure().Extent(agenor.Resume(
+			&pref.Relic{
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						visited[servant.Node().Path]++
+
+						return nil
+					},
+					GetForest: forest,
+				},
+				From:     from,
+				Strategy: enums.ResumeStrategyFastward,
+				Drift:    policy,
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		return err
+	}
+
+	everything := func(ctx context.Context) map[string]int {
+		visited := map[string]int{}
+		Expect(prime(ctx, GinkgoT().TempDir(), func(servant core.Servant) error {
+			visited[servant.Node().Path]++
+
+			return nil
+		})).To(Succeed())
+
+		return visited
+	}
+
+	When("tree has not drifted", func() {
+		It("🧪 should: resume", func(ctx SpecContext) {
+			expected := everything(ctx)
+			visited := map[string]int{}
+			from := interrupt(ctx, GinkgoT().TempDir(), visited)
+
+			Expect(resume(ctx, from, enums.DriftFail, visited)).To(Succeed())
+
+			for path := range expected {
+				Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+			}
+		})
+	})
+
+	When("ancestor has been modified", func() {
+		Context("and drift policy is fail", func() {
+			It("🧪 should: fail to resume", func(ctx SpecContext) {
+				from := interrupt(ctx, GinkgoT().TempDir(), map[string]int{})
+				Expect(fS.WriteFile(bonus, []byte("bonus"), lab.Perms.File)).To(Succeed())
+
+				err := resume(ctx, from, enums.DriftFail, map[string]int{})
+
+				var drift *locale.TreeDriftError
+				Expect(errors.As(err, &drift)).To(BeTrue())
+				Expect(drift.Path).To(Equal(college))
+				Expect(drift.Reason).To(Equal("modified"))
+			})
+		})
+
+		Context("and modification is hidden by the read directory hook", func() {
+			It("🧪 should: resume, since the ancestry is surveyed via the hook", func(ctx SpecContext) {
+				from := interrupt(ctx, GinkgoT().TempDir(), map[string]int{}, hidden())
+				Expect(fS.WriteFile(bonus, []byte("bonus"), lab.Perms.File)).To(Succeed())
+
+				Expect(resume(ctx, from, enums.DriftFail, map[string]int{}, hidden())).To(Succeed())
+			})
+		})
+
+		Context("and drift policy is warn", func() {
+			It("🧪 should: resume regardless", func(ctx SpecContext) {
+				from := interrupt(ctx, GinkgoT().TempDir(), map[string]int{})
+				Expect(fS.WriteFile(bonus, []byte("bonus"), lab.Perms.File)).To(Succeed())
+
+				Expect(resume(ctx, from, enums.DriftWarn, map[string]int{})).To(Succeed())
+			})
+		})
+
+		Context("and drift policy is nearest", func() {
+			It("🧪 should: resume from the modified ancestor", func(ctx SpecContext) {
+				from := interrupt(ctx, GinkgoT().TempDir(), map[string]int{})
+				Expect(fS.WriteFile(bonus, []byte("bonus"), lab.Perms.File)).To(Succeed())
+
+				visited := map[string]int{}
+				Expect(resume(ctx, from, enums.DriftNearest, visited)).To(Succeed())
+				Expect(visited).To(HaveKey(college))
+				Expect(visited).To(HaveKey(bonus))
+			})
+		})
+	})
+
+	When("ancestor is missing", func() {
+		Context("and drift policy is nearest", func() {
+			It("🧪 should: resume from the surviving ancestor", func(ctx SpecContext) {
+				expected := everything(ctx)
+				from := interrupt(ctx, GinkgoT().TempDir(), map[string]int{})
+				Expect(fS.RemoveAll(college)).To(Succeed())
+
+				visited := map[string]int{}
+				Expect(resume(ctx, from, enums.DriftNearest, visited)).To(Succeed())
+
+				for path := range expected {
+					if strings.HasPrefix(path, college) {
+						Expect(visited).NotTo(HaveKey(path), "node: '%v' no longer exists", path)
+
+						continue
+					}
+
+					Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+				}
+			})
+		})
+	})
+})
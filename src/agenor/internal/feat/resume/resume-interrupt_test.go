@@ -0,0 +1,130 @@
+package resume_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Resume after interrupt", Ordered, func() {
+	var (
+		fS *luna.MemFS
+		rS agenor.TraversalFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		rS = tfs.New()
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	forest := func(_ string) *core.Forest {
+		return &core.Forest{
+			T: fS,
+			R: rS,
+		}
+	}
+
+	DescribeTable("cancellation",
+		func(ctx SpecContext, strategy enums.ResumeStrategy, settings ...pref.Option) {
+			admin := GinkgoT().TempDir()
+			prime := func(ctx context.Context, handler core.Client) error {
+				_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+					&pref.Using{
+						Tree:         lab.Static.RetroWave,
+						Subscription: enums.SubscribeUniversal,
+						Head: pref.Head{
+							Handler:   handler,
+							GetForest: forest,
+						},
+					},
+					append(settings, pref.WithAdminPath(admin))...,
+				)).Navigate(ctx)
+
+				return err
+			}
+
+			everything := []string{}
+			Expect(prime(ctx, func(servant core.Servant) error {
+				everything = append(everything, servant.Node().Path)
+
+				return nil
+			})).To(Succeed())
+
+			visited := map[string]int{}
+			interrupted, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			err := prime(interrupted, func(servant core.Servant) error {
+				node := servant.Node()
+				visited[node.Path]++
+
+				if node.Extension.Name == lab.Static.TeenageColor {
+					cancel()
+				}
+
+				return nil
+			})
+
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+			var saved *locale.TraversalSavedError
+			Expect(errors.As(err, &saved)).To(BeTrue(), "error should carry the saved path")
+			Expect(saved.SavedTo).To(HavePrefix(admin))
+			Expect(len(visited)).To(BeNumerically("<", len(everything)))
+
+			_, err = agenor.Walk().Configure().Extent(agenor.Resume(
+				&pref.Relic{
+					Head: pref.Head{
+						Handler: func(servant core.Servant) error {
+							visited[servant.Node().Path]++
+
+							return nil
+						},
+						GetForest: forest,
+					},
+					From:     saved.SavedTo,
+					Strategy: strategy,
+				},
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+
+			for _, path := range everything {
+				Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+			}
+		},
+		func(strategy enums.ResumeStrategy, settings ...pref.Option) string {
+			return "🧪 should: save state and resume without missing any node: " +
+				lo.Ternary(len(settings) == 0, "depth first", "breadth first")
+		},
+		Entry(nil, enums.ResumeStrategyFastward),
+		Entry(nil, enums.ResumeStrategySpawn, pref.WithBreadthFirst()),
+	)
+})
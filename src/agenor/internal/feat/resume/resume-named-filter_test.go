@@ -0,0 +1,203 @@
+package resume_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+// suffixFilter is a custom filter that matches files by suffix
+type suffixFilter struct {
+	suffix string
+}
+
+func (f *suffixFilter) Description() string {
+	return "suffix: " + f.suffix
+}
+
+func (f *suffixFilter) Validate() error {
+	return nil
+}
+
+func (f *suffixFilter) Source() string {
+	return f.suffix
+}
+
+func (f *suffixFilter) IsMatch(node *core.Node) bool {
+	return !node.IsDirectory() && strings.HasSuffix(node.Extension.Name, f.suffix)
+}
+
+func (f *suffixFilter) IsApplicable(_ *core.Node) bool {
+	return true
+}
+
+func (f *suffixFilter) Scope() enums.FilterScope {
+	return enums.ScopeAll
+}
+
+var _ = Describe("Resume with named custom filter", Ordered, func() {
+	const (
+		suffix = ".flac"
+	)
+
+	var (
+		fS *luna.MemFS
+		rS agenor.TraversalFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		rS = tfs.New()
+
+		agenor.RegisterFilter("suffix", func(params string) (any, error) {
+			return &suffixFilter{suffix: params}, nil
+		})
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	forest := func(_ string) *core.Forest {
+		return &core.Forest{
+			T: fS,
+			R: rS,
+		}
+	}
+
+	prime := func(ctx context.Context, fo *pref.FilterOptions,
+		handler core.Client,
+	) error {
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler:   handler,
+					GetForest: forest,
+				},
+			},
+			pref.WithFilter(fo),
+			pref.WithAdminPath(GinkgoT().TempDir()),
+		)).Navigate(ctx)
+
+		return err
+	}
+
+	// interrupt primes a navigation that is cancelled once the first node has
+	// been visited and returns the path of the resume file that was saved.
+	interrupt := func(ctx context.Context, fo *pref.FilterOptions,
+		visited map[string]int,
+	) string {
+		interrupted, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		err := prime(interrupted, fo, func(servant core.Servant) error {
+			visited[servant.Node().Path]++
+			cancel()
+
+			return nil
+		})
+
+		var saved *locale.TraversalSavedError
+		Expect(errors.As(err, &saved)).To(BeTrue(), "error should carry the saved path")
+
+		return saved.SavedTo
+	}
+
+	resume := func(ctx context.Context, from string, handler core.Client) error {
+		_, err := agenor.Walk().Configure().Extent(agenor.Resume(
+			&pref.Relic{
+				Head: pref.Head{
+					Handler:   handler,
+					GetForest: forest,
+				},
+				From:     from,
+				Strategy: enums.ResumeStrategyFastward,
+			},
+		)).Navigate(ctx)
+
+		return err
+	}
+
+	When("filter is registered", func() {
+		It("🧪 should: re-create the filter on resume", func(ctx SpecContext) {
+			fo := &pref.FilterOptions{
+				Named: &core.NamedFilterDef{
+					Name:   "suffix",
+					Params: suffix,
+				},
+			}
+
+			everything := map[string]int{}
+			Expect(prime(ctx, fo, func(servant core.Servant) error {
+				everything[servant.Node().Path]++
+
+				return nil
+			})).To(Succeed())
+			Expect(everything).NotTo(BeEmpty())
+
+			visited := map[string]int{}
+			from := interrupt(ctx, &pref.FilterOptions{
+				Named: fo.Named,
+			}, visited)
+
+			Expect(resume(ctx, from, func(servant core.Servant) error {
+				node := servant.Node()
+				Expect(node.Extension.Name).To(HaveSuffix(suffix),
+					"node: '%v' should have been filtered out", node.Path,
+				)
+				visited[node.Path]++
+
+				return nil
+			})).To(Succeed())
+
+			for path := range everything {
+				Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+			}
+		})
+	})
+
+	When("filter is not registered", func() {
+		It("🧪 should: fail to resume", func(ctx SpecContext) {
+			from := interrupt(ctx, &pref.FilterOptions{
+				Custom: &suffixFilter{suffix: suffix},
+				Named: &core.NamedFilterDef{
+					Name:   "unregistered",
+					Params: suffix,
+				},
+			}, map[string]int{})
+
+			err := resume(ctx, from, func(_ core.Servant) error {
+				return nil
+			})
+
+			var unregistered *locale.FilterNotRegisteredError
+			Expect(errors.As(err, &unregistered)).To(BeTrue())
+			Expect(unregistered.Name).To(Equal("unregistered"))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
 	"github.com/snivilised/jaywalk/src/agenor/internal/kernel"
 	"github.com/snivilised/jaywalk/src/agenor/internal/opts"
 	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
@@ -79,6 +80,10 @@ func Load(restoration *enclave.RestoreState,
 		return &opts.LoadInfo{}, nil, err
 	}
 
+	if err := filtering.Restore(&result.O.Filter); err != nil {
+		return &opts.LoadInfo{}, nil, err
+	}
+
 	return opts.Bind(result.O, result.Active, settings...)
 }
 
@@ -102,6 +107,7 @@ func Artefacts(inception *kernel.Inception) *kernel.Artefacts {
 			med:      mediator,
 			relic:    relic,
 			load:     inception.Harvest.Loaded(),
+			forest:   inception.Resources.Forest,
 			strategy: strategy,
 		},
 		Mediator:  mediator,
@@ -40,6 +40,10 @@ func (f *FastwardFilter) Source() string {
 
 // IsMatch does this node match the filter
 func (f *FastwardFilter) IsMatch(node *core.Node) bool {
+	if node.Parent == nil {
+		return node.Path == f.source
+	}
+
 	return node.Extension.Name == f.name && (node.Parent.Path == f.parent || f.parent == ".")
 }
 
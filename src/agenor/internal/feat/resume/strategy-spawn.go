@@ -33,6 +33,24 @@ func (s *spawnStrategy) resume(ctx context.Context) (result *enclave.KernelResul
 	// between the previous navigation session and this resume session.
 	s.mediator.Bridge(s.active)
 
+	// a breadth first navigation is not fractured by ancestor, rather it
+	// continues with the pending nodes of the frontier
+	//
+	if len(s.active.Frontier) > 0 {
+		s.complete = true
+
+		return s.mediator.Sweep(ctx, s.active.Frontier)
+	}
+
+	// resuming from the tree, as is the case when rewound after drift, can
+	// not be fractured by ancestor, rather the whole tree is navigated
+	//
+	if s.active.CurrentPath == s.active.Tree {
+		s.complete = true
+
+		return s.mediator.Spawn(ctx, s.active.Tree)
+	}
+
 	result, err = s.crown(ctx, &conclusion{
 		active:    s.active,
 		tree:      s.active.Tree,
@@ -6,6 +6,7 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/jaywalk/src/third/lo"
 	nef "github.com/snivilised/nefilim"
@@ -26,10 +27,27 @@ func (p *controller) Next(_ core.Servant, _ enclave.Inspection) (bool, error) {
 }
 
 func (p *controller) sample(result []fs.DirEntry, _ error,
-	_ fs.ReadDirFS, _ string,
+	_ fs.ReadDirFS, parent string,
 ) ([]fs.DirEntry, error) {
 	files, directories := nef.Separate(result)
 
+	switch p.o.Type {
+	case enums.SampleTypeSize, enums.SampleTypeModified:
+		by := rankers[p.o.Type]
+		files, directories = rank(files, by), rank(directories, by)
+
+	case enums.SampleTypeRandom:
+		files = filtering.Random(files, p.o.NoOf.Files, p.o.Seed, parent)
+		directories = filtering.Random(directories, p.o.NoOf.Directories, p.o.Seed, parent)
+
+	case enums.SampleTypeStride:
+		files = filtering.Stride(files, p.o.Stride, p.o.InReverse)
+		directories = filtering.Stride(directories, p.o.Stride, p.o.InReverse)
+
+	case enums.SampleTypeUndefined, enums.SampleTypeSlice,
+		enums.SampleTypeFilter, enums.SampleTypeCustom:
+	}
+
 	return union(&readResult{
 		files:       files,
 		directories: directories,
@@ -0,0 +1,142 @@
+package sampling_test
+
+import (
+	"strings"
+	"testing/fstest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type rankTE struct {
+	lab.DescribedTE
+	sampleType enums.SampleType
+	reverse    bool
+	expected   []string
+}
+
+var _ = Describe("ranked sample", Ordered, func() {
+	const (
+		tree = "charts"
+	)
+
+	var (
+		fS    *luna.MemFS
+		epoch = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.Local)
+	)
+
+	// track creates a file whose size and modification time are determined
+	// by the supplied byte count and age in minutes after the epoch.
+	track := func(path string, size, minutes int) {
+		fS.MapFS[path] = &fstest.MapFile{
+			Data:    []byte(strings.Repeat("x", size)),
+			Mode:    lab.Perms.File,
+			ModTime: epoch.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		fS = luna.NewMemFS()
+		Expect(fS.MakeDirAll(tree+"/b-sides", lab.Perms.Dir)).To(Succeed())
+
+		track(tree+"/01 - alpha.flac", 10, 4)
+		track(tree+"/02 - beta.flac", 40, 1)
+		track(tree+"/03 - gamma.flac", 20, 3)
+		track(tree+"/04 - delta.flac", 30, 2)
+		track(tree+"/b-sides/05 - epsilon.flac", 5, 5)
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	DescribeTable("top n",
+		func(ctx SpecContext, entry *rankTE) {
+			visited := []string{}
+
+			_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Subscription: enums.SubscribeFiles,
+					Head: pref.Head{
+						Handler: func(servant agenor.Servant) error {
+							visited = append(visited, servant.Node().Extension.Name)
+
+							return nil
+						},
+						GetForest: func(_ string) *core.Forest {
+							return &core.Forest{
+								T: fS,
+								R: tfs.New(),
+							}
+						},
+					},
+					Tree: tree,
+				},
+				agenor.WithSamplingOptions(&pref.SamplingOptions{
+					Type:      entry.sampleType,
+					InReverse: entry.reverse,
+					NoOf: pref.EntryQuantities{
+						Files: 2,
+					},
+				}),
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(visited).To(ConsistOf(entry.expected))
+		},
+		func(entry *rankTE) string {
+			return lab.FormatTestDescription(&entry.DescribedTE)
+		},
+
+		Entry(nil, &rankTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "size",
+				Should: "invoke for 2 largest files per directory",
+			},
+			sampleType: enums.SampleTypeSize,
+			expected:   []string{"02 - beta.flac", "04 - delta.flac", "05 - epsilon.flac"},
+		}),
+
+		Entry(nil, &rankTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "size in reverse",
+				Should: "invoke for 2 smallest files per directory",
+			},
+			sampleType: enums.SampleTypeSize,
+			reverse:    true,
+			expected:   []string{"01 - alpha.flac", "03 - gamma.flac", "05 - epsilon.flac"},
+		}),
+
+		Entry(nil, &rankTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "modified",
+				Should: "invoke for 2 newest files per directory",
+			},
+			sampleType: enums.SampleTypeModified,
+			expected:   []string{"01 - alpha.flac", "03 - gamma.flac", "05 - epsilon.flac"},
+		}),
+
+		Entry(nil, &rankTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "modified in reverse",
+				Should: "invoke for 2 oldest files per directory",
+			},
+			sampleType: enums.SampleTypeModified,
+			reverse:    true,
+			expected:   []string{"02 - beta.flac", "04 - delta.flac", "05 - epsilon.flac"},
+		}),
+	)
+})
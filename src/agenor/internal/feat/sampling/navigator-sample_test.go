@@ -157,6 +157,8 @@ var _ = Describe("feature", Ordered, func() {
 							Description: entry.Filter.Description,
 							Scope:       entry.Filter.Scope,
 							Pattern:     entry.Filter.Pattern,
+							Negate:      entry.Filter.Negate,
+							Poly:        entry.Filter.Poly,
 							Custom:      entry.Filter.Sample,
 						},
 						Custom: entry.Filter.Custom,
@@ -736,6 +738,157 @@ var _ = Describe("feature", Ordered, func() {
 			},
 		}),
 
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "filtered files(filter): first, 2 files",
+				Should: "invoke for at most 2 files per directory",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				Prohibited:   []string{"03 - Mountain Goat.flac"},
+				ExpectedNoOf: lab.Quantities{
+					Files: 24,
+				},
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "glob-ex: items with .flac suffix",
+				Type:        enums.FilterTypeGlobEx,
+				Pattern:     "*|*.flac",
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Files: 2,
+			},
+		}),
+
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "filtered files(filter): first, 2 negated files",
+				Should: "invoke for at most 2 files without .flac suffix per directory",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				Prohibited:   []string{"01 - Liquid Insects.flac"},
+				ExpectedNoOf: lab.Quantities{
+					Files: 15,
+				},
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "glob-ex: items without .flac suffix",
+				Type:        enums.FilterTypeGlobEx,
+				Pattern:     "*|*.flac",
+				Negate:      true,
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Files: 2,
+			},
+		}),
+
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "filtered files(filter): first, 2 files within matching directories",
+				Should: "invoke for at most 2 files per matching directory",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				Prohibited:   []string{"cover.diversions.jpg"},
+				ExpectedNoOf: lab.Quantities{
+					Files: 4,
+				},
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "glob-ex: items with .flac suffix, in directories starting with d",
+				Type:        enums.FilterTypeGlobEx,
+				Pattern:     "d*|*.flac",
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Files: 2,
+			},
+		}),
+
+		// === poly ==========================================================
+
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "poly filtered files(filter): first, 2 files within matching directories",
+				Should: "invoke for at most 2 files per matching directory",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				Prohibited: []string{
+					"03 - Semi Detached.flac", "cover.diversions.jpg", "01 - Cups.flac",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Files: 4,
+				},
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "poly: items with .flac suffix, in directories starting with D",
+				Type:        enums.FilterTypePoly,
+				Poly: &core.PolyFilterDef{
+					File: core.FilterDef{
+						Type:        enums.FilterTypeGlobEx,
+						Description: "glob-ex: items with .flac suffix",
+						Pattern:     "*|*.flac",
+					},
+					Directory: core.FilterDef{
+						Type:        enums.FilterTypeGlob,
+						Description: "glob: directories starting with D",
+						Pattern:     "D*",
+					},
+				},
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Files: 2,
+			},
+		}),
+
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "poly filtered files(filter): first, 2 files within negated directories",
+				Should: "invoke for at most 2 files per directory not matching",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				Prohibited: []string{
+					"01 - Impact USA (The Earth Is Burning- Diversion).flac",
+					"01 - Dark & Long.flac", "03 - Jumbo.flac", "cover.wonky.jpg",
+				},
+				ExpectedNoOf: lab.Quantities{
+					Files: 20,
+				},
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "poly: items with .flac suffix, in directories not starting with D",
+				Type:        enums.FilterTypePoly,
+				Poly: &core.PolyFilterDef{
+					File: core.FilterDef{
+						Type:        enums.FilterTypeGlobEx,
+						Description: "glob-ex: items with .flac suffix",
+						Pattern:     "*|*.flac",
+					},
+					Directory: core.FilterDef{
+						Type:        enums.FilterTypeGlob,
+						Description: "glob: directories not starting with D",
+						Pattern:     "D*",
+						Negate:      true,
+					},
+				},
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Files: 2,
+			},
+		}),
+
 		// === custom ========================================================
 
 		Entry(nil, &lab.SampleTE{
@@ -877,6 +1030,27 @@ var _ = Describe("feature", Ordered, func() {
 			},
 		}),
 
+		Entry(nil, &lab.SampleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "glob-ex spec, without no of files",
+				Should: "return invalid file spec error",
+			},
+			NaviTE: lab.NaviTE{
+				Relative:     "edm/ELECTRONICA",
+				Subscription: enums.SubscribeFiles,
+				ExpectedErr:  locale.ErrInvalidFileSamplingSpecMissingFiles,
+			},
+			Filter: &lab.FilterTE{ // 🍭
+				Description: "glob-ex: items with .flac suffix",
+				Type:        enums.FilterTypeGlobEx,
+				Pattern:     "*|*.flac",
+			},
+			SampleType: enums.SampleTypeFilter,
+			NoOf: pref.EntryQuantities{
+				Directories: 2,
+			},
+		}),
+
 		Entry(nil, &lab.SampleTE{
 			DescribedTE: lab.DescribedTE{
 				Given:  "custom filter not defined",
@@ -907,4 +1081,54 @@ var _ = Describe("feature", Ordered, func() {
 			SampleType: enums.SampleTypeCustom,
 		}),
 	)
+	When("custom filter is named", func() {
+		It("🧪 should: sample without modifying filter definition", func(ctx SpecContext) {
+			agenor.RegisterFilter("cover-sample", func(params string) (any, error) {
+				return &customSamplingFilter{
+					Sample:      agenor.NewCustomSampleFilter(enums.ScopeFile),
+					description: "custom(glob): items with cover prefix",
+					pattern:     params,
+				}, nil
+			})
+
+			def := &core.SampleFilterDef{
+				Type: enums.FilterTypeCustom,
+				Named: &core.NamedFilterDef{
+					Name:   "cover-sample",
+					Params: "cover*",
+				},
+			}
+
+			result, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Subscription: enums.SubscribeFiles,
+					Head: pref.Head{
+						Handler: func(_ agenor.Servant) error {
+							return nil
+						},
+						GetForest: func(_ string) *core.Forest {
+							return &core.Forest{
+								T: fS,
+								R: tfs.New(),
+							}
+						},
+					},
+					Tree: "edm",
+				},
+				agenor.WithSamplingOptions(&pref.SamplingOptions{
+					Type: enums.SampleTypeCustom,
+					NoOf: pref.EntryQuantities{
+						Files: 1,
+					},
+				}),
+				agenor.WithFilter(&pref.FilterOptions{
+					Sample: def,
+				}),
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(result.Metrics().Count(enums.MetricNoFilesInvoked)).To(BeNumerically(">", 0))
+			Expect(def.Custom).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,126 @@
+package sampling_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("spot check sample", Ordered, func() {
+	const (
+		tree = "archive"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		fS = luna.NewMemFS()
+
+		for _, directory := range []string{tree, tree + "/north", tree + "/south"} {
+			Expect(fS.MakeDirAll(directory, lab.Perms.Dir)).To(Succeed())
+
+			for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+				Expect(fS.WriteFile(directory+"/"+name+".flac",
+					[]byte(name), lab.Perms.File,
+				)).To(Succeed())
+			}
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	// navigate returns the paths of the files visited per directory
+	navigate := func(ctx SpecContext, so *pref.SamplingOptions) map[string][]string {
+		visited := map[string][]string{}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Subscription: enums.SubscribeFiles,
+				Head: pref.Head{
+					Handler: func(servant agenor.Servant) error {
+						node := servant.Node()
+						parent := fS.Calc().Dir(node.Path)
+						visited[parent] = append(visited[parent], node.Extension.Name)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+				Tree: tree,
+			},
+			agenor.WithSamplingOptions(so),
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	When("random", func() {
+		It("🧪 should: select same entries for same seed", func(ctx SpecContext) {
+			so := &pref.SamplingOptions{
+				Type: enums.SampleTypeRandom,
+				NoOf: pref.EntryQuantities{
+					Files: 2,
+				},
+				Seed: 7,
+			}
+
+			first := navigate(ctx, so)
+			Expect(first).To(HaveLen(3))
+
+			for directory, names := range first {
+				Expect(names).To(HaveLen(2), "directory: '%v'", directory)
+			}
+
+			services.Reset()
+			Expect(navigate(ctx, so)).To(Equal(first))
+		})
+	})
+
+	When("stride", func() {
+		It("🧪 should: select every k-th entry", func(ctx SpecContext) {
+			visited := navigate(ctx, &pref.SamplingOptions{
+				Type:   enums.SampleTypeStride,
+				Stride: 2,
+			})
+
+			Expect(visited[tree]).To(Equal([]string{"a.flac", "c.flac", "e.flac"}))
+		})
+
+		Context("in reverse, limited by no of files", func() {
+			It("🧪 should: select last every k-th entries", func(ctx SpecContext) {
+				visited := navigate(ctx, &pref.SamplingOptions{
+					Type:      enums.SampleTypeStride,
+					InReverse: true,
+					NoOf: pref.EntryQuantities{
+						Files: 2,
+					},
+					Stride: 2,
+				})
+
+				Expect(visited[tree]).To(Equal([]string{"d.flac", "f.flac"}))
+			})
+		})
+	})
+})
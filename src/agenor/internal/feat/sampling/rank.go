@@ -0,0 +1,58 @@
+package sampling
+
+import (
+	"cmp"
+	"io/fs"
+	"slices"
+
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+)
+
+// ranker compares the info of 2 entries, so that the entry that should be
+// selected first, ranks first.
+type ranker func(a, b fs.FileInfo) int
+
+// rankers maps the ordered sample types to the means of ranking the
+// entries. Entries are ranked in descending order, so that taking the
+// first n entries yields the largest/newest and taking the last n, (ie
+// when sampling in reverse) yields the smallest/oldest.
+var rankers = map[enums.SampleType]ranker{
+	enums.SampleTypeSize: func(a, b fs.FileInfo) int {
+		return cmp.Compare(b.Size(), a.Size())
+	},
+	enums.SampleTypeModified: func(a, b fs.FileInfo) int {
+		return b.ModTime().Compare(a.ModTime())
+	},
+}
+
+type rankedEntry struct {
+	entry fs.DirEntry
+	info  fs.FileInfo
+}
+
+// rank returns the entries ordered by the ranker. The sort is stable, so
+// entries that rank equally retain their original (name) order. Entries
+// whose info can not be acquired, are excluded from the sample.
+func rank(entries []fs.DirEntry, by ranker) []fs.DirEntry {
+	ranked := make([]rankedEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		if info, err := entry.Info(); err == nil {
+			ranked = append(ranked, rankedEntry{
+				entry: entry,
+				info:  info,
+			})
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b rankedEntry) int {
+		return by(a.info, b.info)
+	})
+
+	result := make([]fs.DirEntry, 0, len(ranked))
+	for _, r := range ranked {
+		result = append(result, r.entry)
+	}
+
+	return result
+}
@@ -1,6 +1,8 @@
 package sampling
 
 import (
+	"math/rand/v2"
+
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	"github.com/snivilised/jaywalk/src/agenor/internal/kernel"
@@ -10,6 +12,12 @@ import (
 // IfActive returns a new plugin if sampling is active, otherwise nil
 func IfActive(o *pref.Options, _ enums.Subscription, mediator enclave.Mediator) enclave.Plugin {
 	if o.Sampling.IsSamplingActive() {
+		// the seed is generated once, so that when persisted, a resumed
+		// navigation selects the same random sample.
+		if o.Sampling.Type == enums.SampleTypeRandom && o.Sampling.Seed == 0 {
+			o.Sampling.Seed = rand.Uint64() //nolint:gosec // reproducible, not secure, is required
+		}
+
 		return &plugin{
 			BasePlugin: kernel.BasePlugin{
 				O:             o,
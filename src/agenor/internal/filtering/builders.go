@@ -27,6 +27,15 @@ func buildNativeNodeFilter(definition *core.FilterDef) (core.TraverseFilter, err
 	case enums.FilterTypeGlob:
 		filter = createGlobFilter(definition, ifNotApplicable)
 
+	case enums.FilterTypeExpression:
+		filter = createExpressionFilter(definition, ifNotApplicable)
+
+	case enums.FilterTypeCompound:
+		filter, err = buildCompoundNodeFilter(definition, ifNotApplicable)
+
+	case enums.FilterTypeIgnore:
+		filter = createIgnoreFilter(definition, ifNotApplicable)
+
 	case enums.FilterTypeCustom, enums.FilterTypePoly:
 		return nil, nil
 
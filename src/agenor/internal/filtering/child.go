@@ -32,12 +32,27 @@ func NewChild(def *core.ChildFilterDef) (core.ChildTraverseFilter, error) {
 			},
 		}
 
+	case enums.FilterTypeExpression:
+		filter = &ChildExpression{
+			Child: Child{
+				Name:    def.Description,
+				Pattern: def.Pattern,
+				Negate:  def.Negate,
+			},
+		}
+
 	case enums.FilterTypeGlobEx:
 		return nil, locale.ErrFilterChildGlobExNotSupported
 
 	case enums.FilterTypeCustom:
 		return nil, locale.ErrFilterCustomNotSupported
 
+	case enums.FilterTypeCompound:
+		return nil, locale.ErrCompoundFilterIsInvalid
+
+	case enums.FilterTypeIgnore:
+		return nil, locale.ErrFilterIgnoreNotSupported
+
 	case enums.FilterTypeUndefined:
 		return nil, locale.ErrFilterUndefined
 
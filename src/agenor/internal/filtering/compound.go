@@ -0,0 +1,139 @@
+package filtering
+
+import (
+	"strings"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/life"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
+)
+
+func buildCompoundNodeFilter(definition *core.FilterDef,
+	ifNotApplicable bool,
+) (core.TraverseFilter, error) {
+	compoundDef := definition.Compound
+
+	if compoundDef == nil || len(compoundDef.Filters) == 0 ||
+		compoundDef.Mode == enums.CompoundModeUndefined {
+		return nil, locale.ErrCompoundFilterIsInvalid
+	}
+
+	filters := make([]core.TraverseFilter, 0, len(compoundDef.Filters))
+
+	for i := range compoundDef.Filters {
+		constituent := &compoundDef.Filters[i]
+
+		switch constituent.Type {
+		case enums.FilterTypeCustom, enums.FilterTypePoly:
+			return nil, locale.ErrCompoundFilterIsInvalid
+
+		default:
+		}
+
+		filter, err := buildNativeNodeFilter(constituent)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return &Compound{
+		Base: Base{
+			name:            definition.Description,
+			scope:           definition.Scope,
+			negate:          definition.Negate,
+			ifNotApplicable: ifNotApplicable,
+		},
+		mode:    compoundDef.Mode,
+		filters: filters,
+	}, nil
+}
+
+// CompoundFilter =============================================================
+
+// Compound combines any number of native filters, according to its mode:
+//
+//   - all: matches when all of the constituent filters match
+//   - any: matches when at least one of the constituent filters matches
+//   - not: matches when none of the constituent filters match
+//
+// Each constituent is evaluated against the node in its own right, so
+// the scope and negation of a constituent are honoured, as well as its
+// IfNotApplicable setting. The scope and negation of the compound
+// filter itself apply to the combined result. Custom and poly filters
+// can not be combined.
+type Compound struct {
+	Base
+	mode    enums.CompoundMode
+	filters []core.TraverseFilter
+}
+
+// Validate ensures that all constituent filters are valid
+func (f *Compound) Validate() error {
+	if err := f.Base.Validate(); err != nil {
+		return err
+	}
+
+	for _, filter := range f.filters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Track binds the constituent filters that follow the progress of
+// navigation, see Tracker.
+func (f *Compound) Track(forest *core.Forest, controls *life.Controls) {
+	for _, filter := range f.filters {
+		if tracker, ok := filter.(Tracker); ok {
+			tracker.Track(forest, controls)
+		}
+	}
+}
+
+// Source returns the Sources of the constituent filters, separated
+// by the compound mode, eg "*.flac ## all ## ^\._"
+func (f *Compound) Source() string {
+	return strings.Join(lo.Map(f.filters, func(filter core.TraverseFilter, _ int) string {
+		return filter.Source()
+	}), " ## "+f.mode.String()+" ## ")
+}
+
+// IsMatch returns true if the current node matches the combination of
+// the constituent filters.
+func (f *Compound) IsMatch(node *core.Node) bool {
+	if f.IsApplicable(node) {
+		return f.invert(f.combine(node))
+	}
+
+	return f.ifNotApplicable
+}
+
+func (f *Compound) combine(node *core.Node) bool {
+	match := func(filter core.TraverseFilter) bool {
+		return filter.IsMatch(node)
+	}
+	mismatch := func(filter core.TraverseFilter) bool {
+		return !filter.IsMatch(node)
+	}
+
+	switch f.mode {
+	case enums.CompoundModeAll:
+		return lo.EveryBy(f.filters, match)
+
+	case enums.CompoundModeAny:
+		return !lo.EveryBy(f.filters, mismatch)
+
+	case enums.CompoundModeNot:
+		return lo.EveryBy(f.filters, mismatch)
+
+	case enums.CompoundModeUndefined:
+	}
+
+	return false
+}
@@ -0,0 +1,508 @@
+package filtering
+
+import (
+	"io/fs"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/locale"
+)
+
+// The expression grammar, in order of increasing precedence:
+//
+//	or         := and { "||" and }
+//	and        := not { "&&" not }
+//	not        := "!" not | comparison
+//	comparison := bitwise [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) bitwise ]
+//	bitwise    := atom { "&" atom }
+//	atom       := "(" or ")" | attribute | literal
+//
+// Each operand is typed by its kind; numeric operands can only be compared
+// with operands of the same kind, or with a plain number.
+
+type (
+	// kind is the type of an operand within an expression
+	kind uint
+
+	// subject is the entity an expression is evaluated against
+	subject struct {
+		name string
+		info fs.FileInfo
+		now  time.Time
+	}
+
+	// predicate is the compiled form of a boolean operand
+	predicate func(s *subject) bool
+
+	// quantity is the compiled form of a numeric operand. false is returned
+	// if the value is not available for the subject, eg ownership on a file
+	// system that does not support it.
+	quantity func(s *subject) (int64, bool)
+
+	operand struct {
+		kind   kind
+		truth  predicate
+		amount quantity
+	}
+
+	token struct {
+		text string
+		pos  int
+	}
+
+	parser struct {
+		source string
+		tokens []token
+		at     int
+	}
+)
+
+const (
+	kindBool kind = iota
+	kindNumber
+	kindSize
+	kindDuration
+	kindMode
+)
+
+var kindNames = map[kind]string{
+	kindBool:     "boolean",
+	kindNumber:   "number",
+	kindSize:     "size",
+	kindDuration: "duration",
+	kindMode:     "mode",
+}
+
+const (
+	kibi = 1024
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+var (
+	sizeUnits = map[string]float64{
+		"b":  1,
+		"kb": kibi,
+		"mb": kibi * kibi,
+		"gb": kibi * kibi * kibi,
+		"tb": kibi * kibi * kibi * kibi,
+	}
+
+	durationUnits = map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": day,
+		"w": week,
+	}
+
+	// attributes defines the properties of a node that can be referenced
+	// in an expression.
+	attributes = map[string]operand{
+		"size": {
+			kind: kindSize,
+			amount: func(s *subject) (int64, bool) {
+				if s.info == nil {
+					return 0, false
+				}
+
+				return s.info.Size(), true
+			},
+		},
+		"mtime": {
+			kind: kindDuration,
+			amount: func(s *subject) (int64, bool) {
+				if s.info == nil {
+					return 0, false
+				}
+
+				return int64(s.now.Sub(s.info.ModTime())), true
+			},
+		},
+		"mode": {
+			kind: kindMode,
+			amount: func(s *subject) (int64, bool) {
+				if s.info == nil {
+					return 0, false
+				}
+
+				return int64(s.info.Mode().Perm()), true
+			},
+		},
+		"uid": {
+			kind: kindNumber,
+			amount: func(s *subject) (int64, bool) {
+				owner, ok := tfs.OwnerOf(s.info)
+
+				return int64(owner.UID), ok
+			},
+		},
+		"gid": {
+			kind: kindNumber,
+			amount: func(s *subject) (int64, bool) {
+				owner, ok := tfs.OwnerOf(s.info)
+
+				return int64(owner.GID), ok
+			},
+		},
+		"hidden": {
+			kind: kindBool,
+			truth: func(s *subject) bool {
+				return strings.HasPrefix(s.name, ".")
+			},
+		},
+		"dir": {
+			kind: kindBool,
+			truth: func(s *subject) bool {
+				return s.info != nil && s.info.IsDir()
+			},
+		},
+	}
+
+	comparisons = map[string]func(a, b int64) bool{
+		"==": func(a, b int64) bool { return a == b },
+		"!=": func(a, b int64) bool { return a != b },
+		"<":  func(a, b int64) bool { return a < b },
+		"<=": func(a, b int64) bool { return a <= b },
+		">":  func(a, b int64) bool { return a > b },
+		">=": func(a, b int64) bool { return a >= b },
+	}
+)
+
+// compile parses the expression into a predicate, returning a locale
+// error if the expression is invalid.
+func compile(expression string) (predicate, error) {
+	tokens, err := tokenise(expression)
+	if err != nil {
+		return nil, locale.NewInvalidFilterExpressionError(err, expression)
+	}
+
+	p := &parser{
+		source: expression,
+		tokens: tokens,
+	}
+
+	result, err := p.or()
+	if err == nil && p.peek() != "" {
+		err = locale.NewFilterExpressionUnexpectedTokenError(p.peek(), p.tokens[p.at].pos)
+	}
+
+	if err == nil && result.kind != kindBool {
+		err = locale.NewFilterExpressionKindMismatchError(expression,
+			kindNames[kindBool], kindNames[result.kind],
+		)
+	}
+
+	if err != nil {
+		return nil, locale.NewInvalidFilterExpressionError(err, expression)
+	}
+
+	return result.truth, nil
+}
+
+func tokenise(expression string) ([]token, error) {
+	var (
+		tokens []token
+		runes  = []rune(expression)
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+			continue
+
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) ||
+				unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+
+			tokens = append(tokens, token{text: string(runes[start:i]), pos: start})
+
+			continue
+		}
+
+		if i+1 < len(runes) {
+			pair := string(runes[i : i+2])
+
+			if _, found := comparisons[pair]; found || pair == "&&" || pair == "||" {
+				tokens = append(tokens, token{text: pair, pos: i})
+				i += 2
+
+				continue
+			}
+		}
+
+		if !strings.ContainsRune("()!<>&", r) {
+			return nil, locale.NewFilterExpressionUnexpectedTokenError(string(r), i)
+		}
+
+		tokens = append(tokens, token{text: string(r), pos: i})
+		i++
+	}
+
+	return tokens, nil
+}
+
+func (p *parser) peek() string {
+	if p.at < len(p.tokens) {
+		return p.tokens[p.at].text
+	}
+
+	return ""
+}
+
+func (p *parser) next() string {
+	text := p.peek()
+	p.at++
+
+	return text
+}
+
+func (p *parser) or() (operand, error) {
+	return p.logical("||", p.and, func(a, b predicate) predicate {
+		return func(s *subject) bool {
+			return a(s) || b(s)
+		}
+	})
+}
+
+func (p *parser) and() (operand, error) {
+	return p.logical("&&", p.not, func(a, b predicate) predicate {
+		return func(s *subject) bool {
+			return a(s) && b(s)
+		}
+	})
+}
+
+func (p *parser) logical(op string,
+	parse func() (operand, error),
+	combine func(a, b predicate) predicate,
+) (operand, error) {
+	left, err := parse()
+	if err != nil {
+		return left, err
+	}
+
+	for p.peek() == op {
+		p.next()
+
+		right, err := parse()
+		if err != nil {
+			return right, err
+		}
+
+		for _, side := range []operand{left, right} {
+			if side.kind != kindBool {
+				return left, locale.NewFilterExpressionKindMismatchError(op,
+					kindNames[kindBool], kindNames[side.kind],
+				)
+			}
+		}
+
+		left = operand{
+			kind:  kindBool,
+			truth: combine(left.truth, right.truth),
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) not() (operand, error) {
+	if p.peek() != "!" {
+		return p.comparison()
+	}
+
+	p.next()
+
+	inner, err := p.not()
+	if err != nil {
+		return inner, err
+	}
+
+	if inner.kind != kindBool {
+		return inner, locale.NewFilterExpressionKindMismatchError("!",
+			kindNames[kindBool], kindNames[inner.kind],
+		)
+	}
+
+	return operand{
+		kind: kindBool,
+		truth: func(s *subject) bool {
+			return !inner.truth(s)
+		},
+	}, nil
+}
+
+func (p *parser) comparison() (operand, error) {
+	left, err := p.bitwise()
+	if err != nil {
+		return left, err
+	}
+
+	op := p.peek()
+	compare, found := comparisons[op]
+
+	if !found {
+		return left, nil
+	}
+
+	p.next()
+
+	right, err := p.bitwise()
+	if err != nil {
+		return right, err
+	}
+
+	if _, err := unify(op, left, right); err != nil {
+		return left, err
+	}
+
+	return operand{
+		kind: kindBool,
+		truth: func(s *subject) bool {
+			a, aok := left.amount(s)
+			b, bok := right.amount(s)
+
+			return aok && bok && compare(a, b)
+		},
+	}, nil
+}
+
+func (p *parser) bitwise() (operand, error) {
+	left, err := p.atom()
+	if err != nil {
+		return left, err
+	}
+
+	for p.peek() == "&" {
+		p.next()
+
+		right, err := p.atom()
+		if err != nil {
+			return right, err
+		}
+
+		k, err := unify("&", left, right)
+		if err != nil {
+			return left, err
+		}
+
+		a, b := left.amount, right.amount
+		left = operand{
+			kind: k,
+			amount: func(s *subject) (int64, bool) {
+				x, xok := a(s)
+				y, yok := b(s)
+
+				return x & y, xok && yok
+			},
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) atom() (operand, error) {
+	text := p.next()
+
+	switch {
+	case text == "":
+		return operand{}, locale.ErrFilterExpressionUnexpectedEnd
+
+	case text == "(":
+		position := p.tokens[p.at-1].pos
+
+		inner, err := p.or()
+		if err != nil {
+			return inner, err
+		}
+
+		if p.next() != ")" {
+			return inner, locale.NewFilterExpressionMissingParenthesisError(position)
+		}
+
+		return inner, nil
+
+	case unicode.IsDigit(rune(text[0])) || text[0] == '.':
+		return literal(text)
+	}
+
+	if attribute, found := attributes[strings.ToLower(text)]; found {
+		return attribute, nil
+	}
+
+	return operand{}, locale.NewFilterExpressionUnknownAttributeError(text)
+}
+
+// unify checks that the numeric operands of op are compatible, returning
+// the kind of the result.
+func unify(op string, left, right operand) (kind, error) {
+	switch {
+	case left.kind == kindBool || right.kind == kindBool:
+		return kindBool, locale.NewFilterExpressionKindMismatchError(op,
+			kindNames[kindNumber], kindNames[kindBool],
+		)
+
+	case left.kind == right.kind || right.kind == kindNumber:
+		return left.kind, nil
+
+	case left.kind == kindNumber:
+		return right.kind, nil
+	}
+
+	return kindBool, locale.NewFilterExpressionKindMismatchError(op,
+		kindNames[left.kind], kindNames[right.kind],
+	)
+}
+
+// literal parses a numeric literal, which may be qualified by a unit:
+// a size (b, kb, mb, gb, tb; multiples of 1024) or a duration (s, m, h,
+// d, w). Without a unit, a literal is a plain number and may be expressed
+// in any base that strconv.ParseInt supports, eg 0o755 or 0x1ff.
+func literal(text string) (operand, error) {
+	if value, err := strconv.ParseInt(text, 0, 64); err == nil {
+		return constant(kindNumber, value), nil
+	}
+
+	split := strings.IndexFunc(text, unicode.IsLetter)
+	if split <= 0 {
+		return operand{}, locale.NewFilterExpressionInvalidNumberError(text)
+	}
+
+	number, err := strconv.ParseFloat(text[:split], 64)
+	if err != nil {
+		return operand{}, locale.NewFilterExpressionInvalidNumberError(text)
+	}
+
+	unit := text[split:]
+
+	if multiplier, found := durationUnits[unit]; found {
+		return constant(kindDuration, int64(math.Round(number*float64(multiplier)))), nil
+	}
+
+	if multiplier, found := sizeUnits[strings.ToLower(unit)]; found {
+		return constant(kindSize, int64(math.Round(number*multiplier))), nil
+	}
+
+	return operand{}, locale.NewFilterExpressionUnknownUnitError(unit)
+}
+
+func constant(k kind, value int64) operand {
+	return operand{
+		kind: k,
+		amount: func(_ *subject) (int64, bool) {
+			return value, true
+		},
+	}
+}
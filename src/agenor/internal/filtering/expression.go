@@ -0,0 +1,169 @@
+package filtering
+
+import (
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/third/lo"
+)
+
+func createExpressionFilter(def *core.FilterDef,
+	ifNotApplicable bool,
+) core.TraverseFilter {
+	return &Expression{
+		Base: Base{
+			name:            def.Description,
+			scope:           def.Scope,
+			pattern:         def.Pattern,
+			negate:          def.Negate,
+			ifNotApplicable: ifNotApplicable,
+		},
+	}
+}
+
+func nodeSubject(node *core.Node) *subject {
+	info := node.Info
+
+	if info == nil && node.Entry != nil {
+		info, _ = node.Entry.Info()
+	}
+
+	return &subject{
+		name: filepath.Base(node.Path),
+		info: info,
+		now:  time.Now(),
+	}
+}
+
+func entrySubject(entry fs.DirEntry, now time.Time) *subject {
+	info, _ := entry.Info()
+
+	return &subject{
+		name: entry.Name(),
+		info: info,
+		now:  now,
+	}
+}
+
+// ExpressionFilter ===========================================================
+
+// Expression filters on the attributes of a node's file info, by evaluating
+// a boolean expression defined by the pattern, eg:
+//
+//	size > 50MB && mtime < 7d
+//	mode & 0o111 != 0
+//	!hidden
+//
+// The following attributes are supported:
+//
+//   - size: the size in bytes, compared with a plain number or a size
+//     qualified by one of the units b, kb, mb, gb or tb (multiples of 1024)
+//   - mtime: the age of the node's modification time, compared with a
+//     duration qualified by one of the units s, m, h, d or w
+//   - mode: the permission bits, typically masked with an octal number
+//   - uid, gid: the owner's user and group ids, only available for the
+//     local file system on platforms that support them
+//   - hidden: true if the name starts with a dot
+//   - dir: true if the node is a directory
+//
+// Operands are combined with the operators &&, || and !, with parenthesis
+// for grouping. A comparison involving an attribute that is not available,
+// evaluates to false.
+type Expression struct {
+	Base
+	test predicate
+}
+
+// Validate ensures the filter definition is valid
+func (f *Expression) Validate() error {
+	if err := f.Base.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		err error
+	)
+
+	f.test, err = compile(f.pattern)
+
+	return err
+}
+
+// IsMatch returns true if the current node matches the expression.
+func (f *Expression) IsMatch(node *core.Node) bool {
+	if f.IsApplicable(node) {
+		return f.invert(f.test(nodeSubject(node)))
+	}
+
+	return f.ifNotApplicable
+}
+
+// ChildExpressionFilter ======================================================
+
+// ChildExpression is a filter that matches files based on an expression.
+// It is applied to the children of a directory.
+type ChildExpression struct {
+	Child
+	test predicate
+}
+
+// Validate ensures the filter definition is valid
+func (f *ChildExpression) Validate() error {
+	var (
+		err error
+	)
+
+	f.test, err = compile(f.Pattern)
+
+	return err
+}
+
+// Matching returns the collection of files contained within this
+// node's directory that matches this filter.
+func (f *ChildExpression) Matching(children []fs.DirEntry) []fs.DirEntry {
+	now := time.Now()
+
+	return lo.Filter(children, func(entry fs.DirEntry, _ int) bool {
+		return f.invert(f.test(entrySubject(entry, now)))
+	})
+}
+
+// SampleExpressionFilter =====================================================
+
+// SampleExpression is the sampling variant of the expression filter, see
+// SampleRegex.
+type SampleExpression struct {
+	Sample
+	test predicate
+}
+
+// Validate ensures the filter definition is valid
+func (f *SampleExpression) Validate() error {
+	if err := f.Base.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		err error
+	)
+
+	f.test, err = compile(f.pattern)
+
+	return err
+}
+
+// Matching returns the collection of files contained within this
+// node's directory that matches this filter.
+func (f *SampleExpression) Matching(entries []fs.DirEntry) []fs.DirEntry {
+	now := time.Now()
+	filterable, bypass := f.fetch(entries)
+	filtered := lo.Filter(filterable, func(entry fs.DirEntry, _ int) bool {
+		return f.invert(f.test(entrySubject(entry, now)))
+	})
+
+	filtered = append(filtered, bypass...)
+
+	return filtered
+}
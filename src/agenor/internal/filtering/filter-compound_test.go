@@ -0,0 +1,184 @@
+package filtering_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("feature", Ordered, func() {
+	const (
+		tree = "compound"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		file := &fstest.MapFile{
+			Mode: lab.Perms.File,
+		}
+		directory := &fstest.MapFile{
+			Mode: fs.ModeDir | lab.Perms.Dir,
+		}
+
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"compound":                  directory,
+				"compound/._a.flac":         file,
+				"compound/b.flac":           file,
+				"compound/c.mp3":            file,
+				"compound/cover.jpg":        file,
+				"compound/disc-2":           directory,
+				"compound/disc-2/._d.flac":  file,
+				"compound/disc-2/e.flac":    file,
+				"compound/disc-2/notes.txt": file,
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	walk := func(ctx context.Context, def *core.FilterDef) []string {
+		visited := []string{}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Subscription: enums.SubscribeFiles,
+				Head: pref.Head{
+					Handler: func(servant agenor.Servant) error {
+						visited = append(visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+				Tree: tree,
+			},
+			agenor.WithFilter(&pref.FilterOptions{
+				Node: def,
+			}),
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	flac := core.FilterDef{
+		Type:    enums.FilterTypeGlob,
+		Pattern: "*.flac",
+	}
+	hidden := core.FilterDef{
+		Type:    enums.FilterTypeRegex,
+		Pattern: `^\._`,
+	}
+	text := core.FilterDef{
+		Type:    enums.FilterTypeGlob,
+		Pattern: "*.txt",
+	}
+
+	compound := func(mode enums.CompoundMode, negate bool, filters ...core.FilterDef) *core.FilterDef {
+		return &core.FilterDef{
+			Type:        enums.FilterTypeCompound,
+			Description: mode.String(),
+			Scope:       enums.ScopeFile,
+			Negate:      negate,
+			Compound: &core.CompoundFilterDef{
+				Mode:    mode,
+				Filters: filters,
+			},
+		}
+	}
+
+	not := func(def core.FilterDef) core.FilterDef {
+		def.Negate = true
+
+		return def
+	}
+
+	DescribeTable("compound",
+		func(ctx SpecContext, given string, def *core.FilterDef, expected []string) {
+			Expect(walk(ctx, def)).To(ConsistOf(expected), given)
+		},
+		func(given string, _ *core.FilterDef, _ []string) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: invoke for matching files only", given)
+		},
+
+		Entry(nil, "all: flac and not hidden",
+			compound(enums.CompoundModeAll, false, flac, not(hidden)),
+			[]string{"compound/b.flac", "compound/disc-2/e.flac"},
+		),
+		Entry(nil, "any: hidden or text",
+			compound(enums.CompoundModeAny, false, hidden, text),
+			[]string{
+				"compound/._a.flac", "compound/disc-2/._d.flac", "compound/disc-2/notes.txt",
+			},
+		),
+		Entry(nil, "not: neither flac nor text",
+			compound(enums.CompoundModeNot, false, flac, text),
+			[]string{"compound/c.mp3", "compound/cover.jpg"},
+		),
+		Entry(nil, "negated any: neither flac nor text",
+			compound(enums.CompoundModeAny, true, flac, text),
+			[]string{"compound/c.mp3", "compound/cover.jpg"},
+		),
+		Entry(nil, "nested: not hidden and (flac or text)",
+			compound(enums.CompoundModeAll, false,
+				not(hidden),
+				*compound(enums.CompoundModeAny, false, flac, text),
+			),
+			[]string{
+				"compound/b.flac", "compound/disc-2/e.flac", "compound/disc-2/notes.txt",
+			},
+		),
+	)
+
+	DescribeTable("invalid compound",
+		func(given string, def *core.FilterDef) {
+			_, err := filtering.New(def, &pref.FilterOptions{})
+
+			Expect(err).To(MatchError(locale.ErrCompoundFilterIsInvalid), given)
+		},
+		func(given string, _ *core.FilterDef) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: return error", given)
+		},
+
+		Entry(nil, "missing compound", &core.FilterDef{
+			Type: enums.FilterTypeCompound,
+		}),
+		Entry(nil, "no constituents", compound(enums.CompoundModeAll, false)),
+		Entry(nil, "undefined mode", compound(enums.CompoundModeUndefined, false, flac)),
+		Entry(nil, "poly constituent", compound(enums.CompoundModeAny, false,
+			core.FilterDef{
+				Type: enums.FilterTypePoly,
+			},
+		)),
+	)
+})
@@ -0,0 +1,237 @@
+package filtering_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"testing/fstest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("feature", Ordered, func() {
+	const (
+		tree = "attributes"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		now := time.Now()
+		file := func(size int, perm fs.FileMode, age time.Duration) *fstest.MapFile {
+			return &fstest.MapFile{
+				Data:    make([]byte, size),
+				Mode:    perm,
+				ModTime: now.Add(-age),
+			}
+		}
+		directory := &fstest.MapFile{
+			Mode:    fs.ModeDir | lab.Perms.Dir,
+			ModTime: now,
+		}
+
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"attributes":             directory,
+				"attributes/.hidden":     file(10, 0o644, time.Hour),
+				"attributes/big.iso":     file(2048, 0o644, 30*24*time.Hour),
+				"attributes/recent.txt":  file(10, 0o644, time.Hour),
+				"attributes/run.sh":      file(10, 0o755, 30*24*time.Hour),
+				"attributes/sub":         directory,
+				"attributes/sub/big.bin": file(4096, 0o644, time.Hour),
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	walk := func(ctx context.Context, settings ...pref.Option) []string {
+		visited := []string{}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Subscription: enums.SubscribeFiles,
+				Head: pref.Head{
+					Handler: func(servant agenor.Servant) error {
+						visited = append(visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+				Tree: tree,
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	DescribeTable("expression",
+		func(ctx SpecContext, expression string, expected []string) {
+			visited := walk(ctx, agenor.WithFilter(&pref.FilterOptions{
+				Node: &core.FilterDef{
+					Type:        enums.FilterTypeExpression,
+					Description: expression,
+					Pattern:     expression,
+					Scope:       enums.ScopeFile,
+				},
+			}))
+
+			Expect(visited).To(ConsistOf(expected))
+		},
+		func(expression string, _ []string) string {
+			return fmt.Sprintf("🧪 ===> given: expression '%v', should: invoke for matching files only",
+				expression,
+			)
+		},
+
+		Entry(nil, "size > 1kb", []string{
+			"attributes/big.iso", "attributes/sub/big.bin",
+		}),
+		Entry(nil, "size > 1kb && mtime < 7d", []string{
+			"attributes/sub/big.bin",
+		}),
+		Entry(nil, "mode & 0o111 != 0", []string{
+			"attributes/run.sh",
+		}),
+		Entry(nil, "!hidden", []string{
+			"attributes/big.iso", "attributes/recent.txt",
+			"attributes/run.sh", "attributes/sub/big.bin",
+		}),
+		Entry(nil, "hidden || (size >= 4KB)", []string{
+			"attributes/.hidden", "attributes/sub/big.bin",
+		}),
+		Entry(nil, "mtime > 2.5d && !dir", []string{
+			"attributes/big.iso", "attributes/run.sh",
+		}),
+	)
+
+	When("expression used for hibernation", func() {
+		It("🧪 should: wake at first matching node", func(ctx SpecContext) {
+			visited := walk(ctx, agenor.WithHibernationFilterWake(&core.FilterDef{
+				Type:        enums.FilterTypeExpression,
+				Description: "wake at executable",
+				Pattern:     "mode & 0o100 == 0o100",
+				Scope:       enums.ScopeFile,
+			}))
+
+			Expect(visited).To(ContainElement("attributes/run.sh"))
+			Expect(visited).NotTo(ContainElement("attributes/big.iso"))
+		})
+	})
+
+	Context("child and sample", func() {
+		var (
+			entries []fs.DirEntry
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			entries, err = fS.ReadDir(tree)
+			Expect(err).To(Succeed())
+		})
+
+		names := func(entries []fs.DirEntry) []string {
+			result := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				result = append(result, filepath.Join(tree, entry.Name()))
+			}
+
+			return result
+		}
+
+		When("child filter", func() {
+			It("🧪 should: match children", func() {
+				filter, err := filtering.NewChild(&core.ChildFilterDef{
+					Type:    enums.FilterTypeExpression,
+					Pattern: "size < 1kb && !hidden",
+				})
+				Expect(err).To(Succeed())
+
+				matching := names(filter.Matching(entries))
+				Expect(matching).To(ContainElements(
+					"attributes/recent.txt", "attributes/run.sh",
+				))
+				Expect(matching).NotTo(ContainElement("attributes/.hidden"))
+				Expect(matching).NotTo(ContainElement("attributes/big.iso"))
+			})
+		})
+
+		When("sample filter", func() {
+			It("🧪 should: match files and bypass directories", func() {
+				filter, err := filtering.NewSample(&core.SampleFilterDef{
+					Type:    enums.FilterTypeExpression,
+					Pattern: "mtime < 1d",
+					Scope:   enums.ScopeFile,
+				}, &pref.SamplingOptions{
+					NoOf: pref.EntryQuantities{
+						Files: 2,
+					},
+				})
+				Expect(err).To(Succeed())
+				Expect(names(filter.Matching(entries))).To(ConsistOf(
+					"attributes/.hidden", "attributes/recent.txt", "attributes/sub",
+				))
+			})
+		})
+	})
+
+	DescribeTable("invalid expression",
+		func(expression string, reason any) {
+			_, err := filtering.New(&core.FilterDef{
+				Type:    enums.FilterTypeExpression,
+				Pattern: expression,
+			}, &pref.FilterOptions{})
+
+			var invalid *locale.InvalidFilterExpressionError
+			Expect(err).To(BeAssignableToTypeOf(invalid))
+			Expect(errors.Unwrap(err)).To(BeAssignableToTypeOf(reason))
+		},
+		func(expression string, _ any) string {
+			return fmt.Sprintf("🧪 ===> given: expression '%v', should: return error", expression)
+		},
+
+		Entry(nil, "", locale.ErrFilterExpressionUnexpectedEnd),
+		Entry(nil, "size", &locale.FilterExpressionKindMismatchError{}),
+		Entry(nil, "size > 7d", &locale.FilterExpressionKindMismatchError{}),
+		Entry(nil, "colour == 1", &locale.FilterExpressionUnknownAttributeError{}),
+		Entry(nil, "size > 1zb", &locale.FilterExpressionUnknownUnitError{}),
+		Entry(nil, "hidden && size", &locale.FilterExpressionKindMismatchError{}),
+		Entry(nil, "(size > 1", &locale.FilterExpressionMissingParenthesisError{}),
+		Entry(nil, "size = 1", &locale.FilterExpressionUnexpectedTokenError{}),
+		Entry(nil, "hidden > 1", &locale.FilterExpressionKindMismatchError{}),
+		Entry(nil, "size > 1 dir", &locale.FilterExpressionUnexpectedTokenError{}),
+		Entry(nil, "size > 1.2.3", &locale.FilterExpressionInvalidNumberError{}),
+	)
+})
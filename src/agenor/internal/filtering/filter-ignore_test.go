@@ -0,0 +1,267 @@
+package filtering_test
+
+import (
+	"context"
+	"io/fs"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("feature", Ordered, func() {
+	const (
+		tree    = "ignore"
+		ignores = ".gitignore, .jayignore"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		file := &fstest.MapFile{
+			Mode: lab.Perms.File,
+		}
+		directory := &fstest.MapFile{
+			Mode: fs.ModeDir | lab.Perms.Dir,
+		}
+		rules := func(lines string) *fstest.MapFile {
+			return &fstest.MapFile{
+				Data: []byte(lines),
+				Mode: lab.Perms.File,
+			}
+		}
+
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"ignore":                 directory,
+				"ignore/.gitignore":      rules("# root rules\n*.log\nbuild/\n!keep.log\n/top.txt\n"),
+				"ignore/app.log":         file,
+				"ignore/build":           directory,
+				"ignore/build/out.bin":   file,
+				"ignore/docs":            directory,
+				"ignore/docs/a.tmp":      file,
+				"ignore/docs/debug.log":  file,
+				"ignore/keep.log":        file,
+				"ignore/notes.txt":       file,
+				"ignore/src":             directory,
+				"ignore/src/.jayignore":  rules("!*.log\n*.tmp\n"),
+				"ignore/src/build":       directory,
+				"ignore/src/build/x.o":   file,
+				"ignore/src/debug.log":   file,
+				"ignore/src/main.go":     file,
+				"ignore/src/scratch.tmp": file,
+				"ignore/src/top.txt":     file,
+				"ignore/tests":           directory,
+				"ignore/tests/trace.log": file,
+				"ignore/top.txt":         file,
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	navigate := func(ctx context.Context,
+		subscription enums.Subscription,
+		def *core.FilterDef,
+		settings ...pref.Option,
+	) ([]string, error) {
+		visited := []string{}
+
+		if def != nil {
+			settings = append(settings, agenor.WithFilter(&pref.FilterOptions{
+				Node: def,
+			}))
+		}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Subscription: subscription,
+				Head: pref.Head{
+					Handler: func(servant agenor.Servant) error {
+						visited = append(visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+				Tree: tree,
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		return visited, err
+	}
+
+	walk := func(ctx context.Context, subscription enums.Subscription, def *core.FilterDef) []string {
+		visited, err := navigate(ctx, subscription, def)
+
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	When("ignore files are nested", func() {
+		It("🧪 should: apply rules to the sub-tree of their directory", func(ctx SpecContext) {
+			visited := walk(ctx, enums.SubscribeUniversal, &core.FilterDef{
+				Type:        enums.FilterTypeIgnore,
+				Description: "ignore files",
+				Pattern:     ignores,
+				Scope:       enums.ScopeAll,
+			})
+
+			Expect(visited).To(ConsistOf(
+				"ignore",
+				"ignore/.gitignore",
+				"ignore/docs",
+				"ignore/docs/a.tmp",
+				"ignore/keep.log",
+				"ignore/notes.txt",
+				"ignore/src",
+				"ignore/src/.jayignore",
+				"ignore/src/debug.log",
+				"ignore/src/main.go",
+				"ignore/src/top.txt",
+				"ignore/tests",
+			))
+		})
+	})
+
+	When("negated", func() {
+		It("🧪 should: invoke for ignored files only", func(ctx SpecContext) {
+			visited := walk(ctx, enums.SubscribeFiles, &core.FilterDef{
+				Type:        enums.FilterTypeIgnore,
+				Description: "ignored files",
+				Pattern:     ignores,
+				Scope:       enums.ScopeFile,
+				Negate:      true,
+			})
+
+			Expect(visited).To(ConsistOf(
+				"ignore/app.log",
+				"ignore/build/out.bin",
+				"ignore/docs/debug.log",
+				"ignore/src/build/x.o",
+				"ignore/src/scratch.tmp",
+				"ignore/tests/trace.log",
+				"ignore/top.txt",
+			))
+		})
+	})
+
+	When("combined in a compound", func() {
+		It("🧪 should: track navigation", func(ctx SpecContext) {
+			visited := walk(ctx, enums.SubscribeFiles, &core.FilterDef{
+				Type:        enums.FilterTypeCompound,
+				Description: "logs that are not ignored",
+				Scope:       enums.ScopeFile,
+				Compound: &core.CompoundFilterDef{
+					Mode: enums.CompoundModeAll,
+					Filters: []core.FilterDef{
+						{
+							Type:    enums.FilterTypeIgnore,
+							Pattern: ignores,
+						},
+						{
+							Type:    enums.FilterTypeGlob,
+							Pattern: "*.log",
+						},
+					},
+				},
+			})
+
+			Expect(visited).To(ConsistOf(
+				"ignore/keep.log",
+				"ignore/src/debug.log",
+			))
+		})
+	})
+
+	When("navigating breadth first", func() {
+		It("🧪 should: return error", func(ctx SpecContext) {
+			_, err := navigate(ctx, enums.SubscribeUniversal, &core.FilterDef{
+				Type:        enums.FilterTypeIgnore,
+				Description: "ignore files",
+				Pattern:     ignores,
+				Scope:       enums.ScopeAll,
+			}, agenor.WithBreadthFirst())
+
+			Expect(err).To(MatchError(locale.ErrFilterTrackerBreadthFirstNotSupported))
+		})
+	})
+
+	When("combined in a compound, navigating breadth first", func() {
+		It("🧪 should: return error", func(ctx SpecContext) {
+			_, err := navigate(ctx, enums.SubscribeFiles, &core.FilterDef{
+				Type:        enums.FilterTypeCompound,
+				Description: "logs that are not ignored",
+				Scope:       enums.ScopeFile,
+				Compound: &core.CompoundFilterDef{
+					Mode: enums.CompoundModeAll,
+					Filters: []core.FilterDef{
+						{
+							Type:    enums.FilterTypeIgnore,
+							Pattern: ignores,
+						},
+						{
+							Type:    enums.FilterTypeGlob,
+							Pattern: "*.log",
+						},
+					},
+				},
+			}, agenor.WithBreadthFirst())
+
+			Expect(err).To(MatchError(locale.ErrFilterTrackerBreadthFirstNotSupported))
+		})
+	})
+
+	When("pruning, navigating breadth first", func() {
+		It("🧪 should: return error", func(ctx SpecContext) {
+			_, err := navigate(ctx, enums.SubscribeUniversal, nil,
+				agenor.WithBreadthFirst(),
+				agenor.WithFilter(&pref.FilterOptions{
+					Prune: &core.FilterDef{
+						Type:    enums.FilterTypeIgnore,
+						Pattern: ignores,
+						Negate:  true,
+					},
+				}),
+			)
+
+			Expect(err).To(MatchError(locale.ErrFilterTrackerBreadthFirstNotSupported))
+		})
+	})
+
+	When("used as a child filter", func() {
+		It("🧪 should: return error", func() {
+			_, err := filtering.NewChild(&core.ChildFilterDef{
+				Type:    enums.FilterTypeIgnore,
+				Pattern: ignores,
+			})
+
+			Expect(err).To(MatchError(locale.ErrFilterIgnoreNotSupported))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package filtering
 
 import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/life"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 )
 
@@ -36,4 +37,12 @@ type (
 	filterUsingOptionsFunc func(definition *core.FilterDef,
 		fo *pref.FilterOptions,
 	) (core.TraverseFilter, error)
+
+	// Tracker is implemented by filters whose state follows the progress of
+	// navigation, ie they need to know which directory is being navigated.
+	Tracker interface {
+		// Track binds the filter to the life events of navigation, with the
+		// forest providing access to the file system being navigated.
+		Track(forest *core.Forest, controls *life.Controls)
+	}
 )
@@ -1,6 +1,7 @@
 package filtering
 
 import (
+	"io/fs"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -74,19 +75,7 @@ func (f *GlobEx) IsApplicable(node *core.Node) bool {
 
 func (f *GlobEx) isDirectoryMatch(node *core.Node) bool {
 	if node.Parent != nil {
-		name := strings.ToLower(node.Parent.Extension.Name)
-
-		if result, _ := filepath.Match(
-			f.spec.directoryGlob, name,
-		); !result {
-			return false
-		}
-
-		if excluded, _ := filepath.Match(
-			f.spec.directoryExclusion, name,
-		); excluded {
-			return false
-		}
+		return f.spec.isDirectoryMatch(strings.ToLower(node.Parent.Extension.Name))
 	}
 
 	return true
@@ -110,6 +99,65 @@ func (f *GlobEx) IsMatch(node *core.Node) bool {
 	return f.ifNotApplicable
 }
 
+// SampleGlobExFilter =========================================================
+
+func createSampleGlobExFilter(base Sample) (filter core.SampleTraverseFilter, err error) {
+	var (
+		segments, patterns []string
+		gs                 *globSpec
+	)
+
+	if segments, patterns, err = splitGlobExPattern(base.pattern); err != nil {
+		return nil, err
+	}
+
+	directoryBase, directoryExclusion := splitGlob(segments[0])
+
+	if gs, err = newSpec(directoryBase, directoryExclusion, patterns); err != nil {
+		return nil, err
+	}
+
+	return &SampleGlobEx{
+		Sample: base,
+		spec:   gs,
+	}, nil
+}
+
+// SampleGlobEx is the sampling variant of the extended glob filter. The
+// file patterns are applied to the names of the files being sampled and the
+// directory glob is applied to the name of the directory whose files are
+// being sampled. As with GlobEx, use of this filter implies file scope, so
+// directories bypass the filter.
+type SampleGlobEx struct {
+	Sample
+	spec *globSpec
+}
+
+// Matching returns the collection of files contained within this
+// node's directory that matches this filter. As the directory is not
+// known, only the file patterns are applied.
+func (f *SampleGlobEx) Matching(entries []fs.DirEntry) []fs.DirEntry {
+	filterable, bypass := f.fetch(entries)
+	filtered := lo.Filter(filterable, func(entry fs.DirEntry, _ int) bool {
+		return f.invert(f.spec.IsMatch(entry.Name()))
+	})
+
+	return append(filtered, bypass...)
+}
+
+// MatchingWithin returns the collection of files contained within the
+// parent directory that matches this filter. None of the files match
+// when the parent does not match the directory glob.
+func (f *SampleGlobEx) MatchingWithin(parent string, entries []fs.DirEntry) []fs.DirEntry {
+	if !f.spec.isDirectoryMatch(strings.ToLower(filepath.Base(parent))) {
+		_, bypass := f.fetch(entries)
+
+		return bypass
+	}
+
+	return f.Matching(entries)
+}
+
 // patternSpec represents a file pattern specification, which consists of
 // the base and extension parts
 type (
@@ -193,6 +241,17 @@ func (s *patternSpec) match(name string) bool {
 	return m
 }
 
+// isDirectoryMatch determines if the directory glob matches the given name.
+func (s globSpec) isDirectoryMatch(name string) bool {
+	if result, _ := filepath.Match(s.directoryGlob, name); !result {
+		return false
+	}
+
+	excluded, _ := filepath.Match(s.directoryExclusion, name)
+
+	return !excluded
+}
+
 // IsMatch determines if the glob spec matches the given name.
 func (s globSpec) IsMatch(name string) bool {
 	for _, spec := range s.specs {
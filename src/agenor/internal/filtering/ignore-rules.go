@@ -0,0 +1,181 @@
+package filtering
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+type (
+	// rule is a single compiled line of an ignore file
+	rule struct {
+		rex      *regexp.Regexp
+		negate   bool
+		dirsOnly bool
+	}
+
+	// rules is the ordered collection of rules of an ignore file
+	rules []rule
+)
+
+// parseRules compiles the content of an ignore file, which follows the
+// syntax of .gitignore:
+//
+//   - blank lines and lines starting with # are ignored, a leading
+//     backslash escapes a # or ! that is part of the pattern
+//   - a leading ! negates the pattern, re-including a node that a previous
+//     pattern excluded
+//   - a trailing / restricts the pattern to directories
+//   - a pattern containing a / is anchored to the directory containing
+//     the ignore file, otherwise it matches the name at any depth below it
+//   - * and ? do not match a /, ** matches any number of directories
+//
+// Lines that can't be compiled are disregarded.
+func parseRules(content []byte) rules {
+	var (
+		result  rules
+		scanner = bufio.NewScanner(bytes.NewReader(content))
+	)
+
+	for scanner.Scan() {
+		if r, ok := parseRule(scanner.Text()); ok {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+func parseRule(line string) (rule, bool) {
+	var (
+		r rule
+	)
+
+	line = strings.TrimRight(strings.TrimSuffix(line, "\r"), " \t")
+
+	if line == "" || strings.HasPrefix(line, "#") {
+		return r, false
+	}
+
+	switch {
+	case strings.HasPrefix(line, "!"):
+		r.negate = true
+		line = line[1:]
+
+	case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		r.dirsOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	if line == "" {
+		return r, false
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	expression := translate(line)
+	if !anchored && !strings.HasPrefix(expression, "(?:.*/)?") {
+		expression = "(?:.*/)?" + expression
+	}
+
+	rex, err := regexp.Compile("^" + expression + "$")
+	if err != nil {
+		return r, false
+	}
+
+	r.rex = rex
+
+	return r, true
+}
+
+// translate converts an ignore pattern into a regular expression
+func translate(pattern string) string {
+	var (
+		builder strings.Builder
+		runes   = []rune(pattern)
+	)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			leading := i == 0 || runes[i-1] == '/'
+			trailing := i+2 == len(runes) || runes[i+2] == '/'
+
+			switch {
+			case leading && i+2 < len(runes):
+				// "**/" matches zero or more directories
+				builder.WriteString("(?:.*/)?")
+				i += 2
+
+			case leading && trailing:
+				// "/**" matches everything inside
+				builder.WriteString(".*")
+				i++
+
+			default:
+				builder.WriteString("[^/]*")
+				i++
+			}
+
+		case r == '*':
+			builder.WriteString("[^/]*")
+
+		case r == '?':
+			builder.WriteString("[^/]")
+
+		case r == '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+
+			if end == len(runes) {
+				builder.WriteString(regexp.QuoteMeta(string(r)))
+
+				continue
+			}
+
+			class := string(runes[i+1 : end])
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			builder.WriteString("[" + class + "]")
+			i = end
+
+		case r == '\\' && i+1 < len(runes):
+			i++
+			builder.WriteString(regexp.QuoteMeta(string(runes[i])))
+
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return builder.String()
+}
+
+// match returns the verdict of the last rule that matches the path, which
+// is relative to the directory containing the ignore file. found is false
+// if none of the rules match.
+func (rs rules) match(path string, isDir bool) (ignored, found bool) {
+	for _, r := range rs {
+		if r.dirsOnly && !isDir {
+			continue
+		}
+
+		if r.rex.MatchString(path) {
+			ignored, found = !r.negate, true
+		}
+	}
+
+	return ignored, found
+}
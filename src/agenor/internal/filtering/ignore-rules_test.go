@@ -0,0 +1,64 @@
+package filtering
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type (
+	// IgnoreRuleTE is a test entry for ignore rule tests.
+	IgnoreRuleTE struct {
+		// Rules is the content of the ignore file.
+		Rules string
+		// Path is the path relative to the ignore file's directory.
+		Path string
+		// IsDir is true if the path denotes a directory.
+		IsDir bool
+		// Ignored is the expected verdict.
+		Ignored bool
+		// Found is true if any rule is expected to match.
+		Found bool
+	}
+)
+
+var _ = Describe("IgnoreRules", func() {
+	DescribeTable("match",
+		func(entry *IgnoreRuleTE) {
+			ignored, found := parseRules([]byte(entry.Rules)).match(entry.Path, entry.IsDir)
+
+			Expect(found).To(Equal(entry.Found), "failed: found")
+			Expect(ignored).To(Equal(entry.Ignored), "failed: ignored")
+		},
+		func(entry *IgnoreRuleTE) string {
+			return fmt.Sprintf("Given: rules %q, path: '%v' 🧪 should: ignore(%v)",
+				entry.Rules, entry.Path, entry.Ignored,
+			)
+		},
+
+		Entry(nil, &IgnoreRuleTE{Rules: "*.log", Path: "a.log", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "*.log", Path: "x/y/a.log", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "*.log", Path: "a.txt"}),
+		Entry(nil, &IgnoreRuleTE{Rules: "# *.log\n\n", Path: "a.log"}),
+		Entry(nil, &IgnoreRuleTE{Rules: `\#a`, Path: "#a", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: `\!a`, Path: "!a", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "*.log\n!keep.log", Path: "keep.log", Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "!keep.log\n*.log", Path: "keep.log", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "build/", Path: "build", IsDir: true, Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "build/", Path: "build"}),
+		Entry(nil, &IgnoreRuleTE{Rules: "/top", Path: "top", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "/top", Path: "sub/top"}),
+		Entry(nil, &IgnoreRuleTE{Rules: "doc/*.md", Path: "doc/a.md", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "doc/*.md", Path: "doc/x/a.md"}),
+		Entry(nil, &IgnoreRuleTE{Rules: "**/cache", Path: "cache", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "**/cache", Path: "a/b/cache", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "a/**/z", Path: "a/z", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "a/**/z", Path: "a/b/c/z", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "out/**", Path: "out/a/b", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "file?.txt", Path: "file1.txt", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "file[!0-9].txt", Path: "file1.txt"}),
+		Entry(nil, &IgnoreRuleTE{Rules: "file[!0-9].txt", Path: "fileA.txt", Ignored: true, Found: true}),
+		Entry(nil, &IgnoreRuleTE{Rules: "[unterminated", Path: "[unterminated", Ignored: true, Found: true}),
+	)
+})
@@ -0,0 +1,189 @@
+package filtering
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/life"
+	"github.com/snivilised/jaywalk/src/third/lo"
+)
+
+func createIgnoreFilter(def *core.FilterDef,
+	ifNotApplicable bool,
+) core.TraverseFilter {
+	return &Ignore{
+		Base: Base{
+			name:            def.Description,
+			scope:           def.Scope,
+			pattern:         def.Pattern,
+			negate:          def.Negate,
+			ifNotApplicable: ifNotApplicable,
+		},
+	}
+}
+
+// frame represents the ignore rules in force for a directory that
+// has been descended
+type frame struct {
+	directory string
+	rules     rules
+	ignored   bool
+}
+
+// IgnoreFilter ===============================================================
+
+// Ignore filters out nodes according to the rules of the ignore files,
+// eg .gitignore or .jayignore, found in the directories being navigated.
+// The pattern is a csv of the names of the ignore files to read; when
+// more than one is present in a directory, their rules are applied in the
+// order specified.
+//
+// The rules of an ignore file apply to the sub-tree of the directory that
+// contains it and are brought into and out of scope as that directory is
+// descended and ascended. Rules of nested ignore files are applied after
+// those of their parents, so take precedence; within a file, the last rule
+// that matches wins. As is the case for git, a node whose parent directory
+// is ignored, can not be re-included.
+//
+// The node matches the filter when it is not ignored. Since the filter
+// follows the progress of navigation, it is only effective as the node
+// filter.
+type Ignore struct {
+	Base
+	names  []string
+	reader fs.ReadFileFS
+	frames []*frame
+}
+
+// Validate ensures the filter definition is valid
+func (f *Ignore) Validate() error {
+	if err := f.Base.Validate(); err != nil {
+		return err
+	}
+
+	f.names = lo.Reject(
+		lo.Map(strings.Split(f.pattern, ","), func(name string, _ int) string {
+			return strings.TrimSpace(name)
+		}),
+		func(name string, _ int) bool {
+			return name == ""
+		},
+	)
+
+	return nil
+}
+
+// Track binds the filter to the descend and ascend events, so that the
+// ignore files are read from the forest as directories are navigated.
+func (f *Ignore) Track(forest *core.Forest, controls *life.Controls) {
+	f.reader = forest.T
+
+	controls.Descend.On(f.descend)
+	controls.Ascend.On(f.ascend)
+}
+
+// IsMatch returns true if the current node is not ignored.
+func (f *Ignore) IsMatch(node *core.Node) bool {
+	if f.IsApplicable(node) {
+		return f.invert(!f.excluded(node))
+	}
+
+	return f.ifNotApplicable
+}
+
+func (f *Ignore) descend(node *core.Node) {
+	f.unwind(node.Path)
+
+	// descend notifications are muted when fast forwarding to a resume
+	// point, so the frames of any missing ancestors are recovered here
+	//
+	if node.Parent != nil && f.top() != node.Parent.Path {
+		f.descend(node.Parent)
+	}
+
+	f.frames = append(f.frames, &frame{
+		directory: node.Path,
+		rules:     f.load(node.Path),
+		ignored:   f.excluded(node),
+	})
+}
+
+func (f *Ignore) ascend(node *core.Node) {
+	if f.top() == node.Path {
+		f.frames = f.frames[:len(f.frames)-1]
+	}
+}
+
+func (f *Ignore) top() string {
+	if len(f.frames) == 0 {
+		return ""
+	}
+
+	return f.frames[len(f.frames)-1].directory
+}
+
+// unwind discards the frames of directories that are not ancestors of path
+func (f *Ignore) unwind(path string) {
+	for len(f.frames) > 0 {
+		if rel, err := filepath.Rel(f.top(), path); err == nil && rel != "." &&
+			rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return
+		}
+
+		f.frames = f.frames[:len(f.frames)-1]
+	}
+}
+
+func (f *Ignore) load(directory string) rules {
+	var (
+		result rules
+	)
+
+	if f.reader == nil {
+		return result
+	}
+
+	for _, name := range f.names {
+		if content, err := f.reader.ReadFile(filepath.Join(directory, name)); err == nil {
+			result = append(result, parseRules(content)...)
+		}
+	}
+
+	return result
+}
+
+// excluded determines whether the node is ignored by the rules of its
+// ancestors, disregarding the node's own frame when it is a directory
+// that has already been descended.
+func (f *Ignore) excluded(node *core.Node) bool {
+	frames := f.frames
+
+	if len(frames) > 0 && frames[len(frames)-1].directory == node.Path {
+		frames = frames[:len(frames)-1]
+	}
+
+	if len(frames) == 0 {
+		return false
+	}
+
+	if frames[len(frames)-1].ignored {
+		return true
+	}
+
+	ignored := false
+
+	for _, fr := range frames {
+		rel, err := filepath.Rel(fr.directory, node.Path)
+		if err != nil {
+			continue
+		}
+
+		if verdict, found := fr.rules.match(filepath.ToSlash(rel), node.IsDirectory()); found {
+			ignored = verdict
+		}
+	}
+
+	return ignored
+}
@@ -2,9 +2,12 @@ package filtering
 
 import (
 	"fmt"
+	"io/fs"
+	"path/filepath"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/locale"
 )
 
 // Poly is a dual filter that allows files and directories to be filtered
@@ -86,3 +89,152 @@ func (f *Poly) IsApplicable(node *core.Node) bool {
 func (f *Poly) Scope() enums.FilterScope {
 	return f.File.Scope() | f.Directory.Scope()
 }
+
+// SamplePolyFilter ===========================================================
+
+func createSamplePolyFilter(base Sample,
+	def *core.PolyFilterDef,
+) (filter core.SampleTraverseFilter, err error) {
+	if def == nil {
+		return nil, locale.ErrPolyFilterIsInvalid
+	}
+
+	var (
+		file, directory core.SampleTraverseFilter
+	)
+
+	if file, err = createSampleConstituent(&def.File, enums.ScopeFile); err != nil {
+		return nil, err
+	}
+
+	if directory, err = createSampleConstituent(&def.Directory, enums.ScopeDirectory); err != nil {
+		return nil, err
+	}
+
+	return &SamplePoly{
+		Sample:    base,
+		File:      file,
+		Directory: directory,
+	}, nil
+}
+
+// createSampleConstituent creates the sample filter for either the file or
+// the directory constituent of a poly filter, enforcing the scope. Only the
+// file constituent can be a glob-ex filter.
+func createSampleConstituent(def *core.FilterDef,
+	scope enums.FilterScope,
+) (core.SampleTraverseFilter, error) {
+	base := Sample{
+		Base: Base{
+			name:    def.Description,
+			scope:   scope,
+			pattern: def.Pattern,
+			negate:  def.Negate,
+		},
+	}
+
+	switch def.Type {
+	case enums.FilterTypeGlobEx:
+		if scope.IsFile() {
+			return createSampleGlobExFilter(base)
+		}
+
+	case enums.FilterTypeRegex:
+		return &SampleRegex{
+			Sample: base,
+		}, nil
+
+	case enums.FilterTypeGlob:
+		return &SampleGlob{
+			Sample: base,
+		}, nil
+
+	case enums.FilterTypeExpression:
+		return &SampleExpression{
+			Sample: base,
+		}, nil
+
+	case enums.FilterTypeUndefined:
+		return nil, locale.ErrFilterMissingType
+
+	case enums.FilterTypeCustom, enums.FilterTypePoly,
+		enums.FilterTypeCompound, enums.FilterTypeIgnore:
+	}
+
+	return nil, locale.ErrPolyFilterIsInvalid
+}
+
+// SamplePoly is the sampling variant of the poly filter. The file filter is
+// applied to the files being sampled and the directory filter is applied to
+// the directory whose files are being sampled. As with Poly, directories
+// bypass the filter.
+type SamplePoly struct {
+	Sample
+
+	// File is the filter that applies to the files being sampled.
+	File core.SampleTraverseFilter
+
+	// Directory is the filter that applies to the directory whose files
+	// are being sampled.
+	Directory core.SampleTraverseFilter
+}
+
+// Description returns the description of the poly filter.
+func (f *SamplePoly) Description() string {
+	return fmt.Sprintf("Poly - FILE: '%v', DIRECTORY: '%v'",
+		f.File.Description(), f.Directory.Description(),
+	)
+}
+
+// Validate ensures that both filters definition are valid
+func (f *SamplePoly) Validate() error {
+	if err := f.File.Validate(); err != nil {
+		return err
+	}
+
+	return f.Directory.Validate()
+}
+
+// Matching returns the collection of files contained within this
+// node's directory that matches this filter. As the directory is not
+// known, only the file filter is applied.
+func (f *SamplePoly) Matching(entries []fs.DirEntry) []fs.DirEntry {
+	return f.File.Matching(entries)
+}
+
+// MatchingWithin returns the collection of files contained within the
+// parent directory that matches this filter. None of the files match
+// when the parent does not match the directory filter.
+func (f *SamplePoly) MatchingWithin(parent string, entries []fs.DirEntry) []fs.DirEntry {
+	if len(f.Directory.Matching([]fs.DirEntry{directoryEntry(filepath.Base(parent))})) == 0 {
+		_, bypass := f.fetch(entries)
+
+		return bypass
+	}
+
+	if sensitive, ok := f.File.(DirectorySensitive); ok {
+		return sensitive.MatchingWithin(parent, entries)
+	}
+
+	return f.File.Matching(entries)
+}
+
+// directoryEntry represents the directory whose entries are being sampled,
+// of which only the name is known.
+type directoryEntry string
+
+func (e directoryEntry) Name() string {
+	return string(e)
+}
+
+func (e directoryEntry) IsDir() bool {
+	return true
+}
+
+func (e directoryEntry) Type() fs.FileMode {
+	return fs.ModeDir
+}
+
+func (e directoryEntry) Info() (fs.FileInfo, error) {
+	return nil, fs.ErrNotExist
+}
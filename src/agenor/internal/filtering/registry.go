@@ -0,0 +1,96 @@
+package filtering
+
+import (
+	"sync"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/locale"
+)
+
+var registry = struct {
+	sync.RWMutex
+	factories map[string]core.FilterFactory
+}{
+	factories: make(map[string]core.FilterFactory),
+}
+
+// Register registers the factory that creates the custom filter identified
+// by name, so that a custom filter can be re-created from its NamedFilterDef,
+// in particular on resume. A factory previously registered with the same name
+// is replaced.
+func Register(name string, factory core.FilterFactory) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.factories[name] = factory
+}
+
+// NewNamed creates the custom node filter identified by the named filter
+// definition, using its registered factory.
+func NewNamed(def *core.NamedFilterDef) (core.TraverseFilter, error) {
+	created, err := create(def)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := created.(core.TraverseFilter)
+	if !ok || filter == nil {
+		return nil, locale.NewRegisteredFilterMismatchError(def.Name, "node")
+	}
+
+	return filter, nil
+}
+
+// NewNamedSample creates the custom sample filter identified by the named
+// filter definition, using its registered factory.
+func NewNamedSample(def *core.NamedFilterDef) (core.SampleTraverseFilter, error) {
+	created, err := create(def)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, ok := created.(core.SampleTraverseFilter)
+	if !ok || filter == nil {
+		return nil, locale.NewRegisteredFilterMismatchError(def.Name, "sample")
+	}
+
+	return filter, nil
+}
+
+// Restore re-creates the custom filters, identified by their named filter
+// definitions, that are missing from the filter options, as is the case
+// when the options have been loaded for resume.
+func Restore(fo *pref.FilterOptions) error {
+	if fo.Custom == nil && fo.Named != nil {
+		filter, err := NewNamed(fo.Named)
+		if err != nil {
+			return err
+		}
+
+		fo.Custom = filter
+	}
+
+	if fo.Sample != nil && fo.Sample.Custom == nil && fo.Sample.Named != nil {
+		filter, err := NewNamedSample(fo.Sample.Named)
+		if err != nil {
+			return err
+		}
+
+		fo.Sample.Custom = filter
+	}
+
+	return nil
+}
+
+func create(def *core.NamedFilterDef) (any, error) {
+	registry.RLock()
+	factory, found := registry.factories[def.Name]
+	registry.RUnlock()
+
+	if !found || factory == nil {
+		return nil, locale.NewFilterNotRegisteredError(def.Name)
+	}
+
+	return factory(def.Params)
+}
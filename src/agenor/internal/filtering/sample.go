@@ -1,7 +1,10 @@
 package filtering
 
 import (
+	"hash/fnv"
 	"io/fs"
+	"math/rand/v2"
+	"slices"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
@@ -15,6 +18,14 @@ import (
 
 type (
 	candidates func(entries []fs.DirEntry) (wanted, others []fs.DirEntry)
+
+	// DirectorySensitive is implemented by sample filters whose result also
+	// depends upon the directory whose entries are being sampled.
+	DirectorySensitive interface {
+		// MatchingWithin returns the collection of entries contained within
+		// the parent directory that matches the filter.
+		MatchingWithin(parent string, entries []fs.DirEntry) []fs.DirEntry
+	}
 )
 
 // Sample is a filter that samples files or directories based on a pattern.
@@ -34,10 +45,17 @@ func NewSample(def *core.SampleFilterDef,
 		return nil, locale.ErrFilterIsNil
 	}
 
+	scope := def.Scope
+
+	if def.Type == enums.FilterTypeGlobEx || def.Type == enums.FilterTypePoly {
+		// use of these types of filter implies the correct scope, ie file scope.
+		scope = enums.ScopeFile
+	}
+
 	base := Sample{
 		Base: Base{
 			name:    def.Description,
-			scope:   def.Scope.Scrub(),
+			scope:   scope.Scrub(),
 			pattern: def.Pattern,
 			negate:  def.Negate,
 		},
@@ -53,6 +71,13 @@ func NewSample(def *core.SampleFilterDef,
 
 	switch def.Type {
 	case enums.FilterTypeGlobEx:
+		globEx, err := createSampleGlobExFilter(base)
+		if err != nil {
+			return nil, err
+		}
+
+		filter = globEx
+
 	case enums.FilterTypeRegex:
 		filter = &SampleRegex{
 			Sample: base,
@@ -62,13 +87,40 @@ func NewSample(def *core.SampleFilterDef,
 			Sample: base,
 		}
 
+	case enums.FilterTypeExpression:
+		filter = &SampleExpression{
+			Sample: base,
+		}
+
 	case enums.FilterTypeCustom:
-		if def.Custom == nil {
+		custom := def.Custom
+
+		if custom == nil && def.Named != nil {
+			named, err := NewNamedSample(def.Named)
+			if err != nil {
+				return nil, err
+			}
+
+			custom = named
+		}
+
+		if custom == nil {
 			return nil, locale.ErrFilterIsNil
 		}
 
-		filter = def.Custom
+		filter = custom
 	case enums.FilterTypePoly:
+		poly, err := createSamplePolyFilter(base, def.Poly)
+		if err != nil {
+			return nil, err
+		}
+
+		filter = poly
+
+	case enums.FilterTypeCompound:
+		return nil, locale.ErrCompoundFilterIsInvalid
+	case enums.FilterTypeIgnore:
+		return nil, locale.ErrFilterIgnoreNotSupported
 	case enums.FilterTypeUndefined:
 		return nil, locale.ErrFilterMissingType
 	}
@@ -135,3 +187,50 @@ func NewCustomSampleFilter(scope enums.FilterScope) Sample {
 		},
 	}
 }
+
+// Random returns a reproducible random selection of n of the entries. The
+// selection is determined by the seed and the salt, (typically the path of
+// the directory being read), so that the same entries are selected each time
+// the directory is read with the same seed. The selected entries retain their
+// relative order. When n is 0, all entries are selected.
+func Random(entries []fs.DirEntry, n uint, seed uint64, salt string) []fs.DirEntry {
+	if n == 0 || n >= uint(len(entries)) {
+		return entries
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(salt))
+
+	indices := rand.New( //nolint:gosec // reproducible, not secure, is required
+		rand.NewPCG(seed, hash.Sum64()),
+	).Perm(len(entries))[:n]
+	slices.Sort(indices)
+
+	return lo.Map(indices, func(index, _ int) fs.DirEntry {
+		return entries[index]
+	})
+}
+
+// Stride returns every k-th entry, starting with the first, or the last when
+// in reverse. The selected entries retain their relative order. A stride of
+// 0 or 1 selects all entries.
+func Stride(entries []fs.DirEntry, k uint, reverse bool) []fs.DirEntry {
+	if k <= 1 {
+		return entries
+	}
+
+	selected := make([]fs.DirEntry, 0, (uint(len(entries))+k-1)/k)
+
+	for i := range uint(len(entries)) {
+		if i%k == 0 {
+			index := lo.Ternary(reverse, uint(len(entries))-1-i, i)
+			selected = append(selected, entries[index])
+		}
+	}
+
+	if reverse {
+		slices.Reverse(selected)
+	}
+
+	return selected
+}
@@ -0,0 +1,40 @@
+package filtering
+
+import (
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/locale"
+)
+
+// Traceable ensures that the filter can follow the progress of navigation
+// in the order requested. When navigating breadth first, the descend and
+// ascend events of a directory do not bracket the navigation of its
+// children, so a filter that tracks navigation, eg an ignore filter, would
+// apply the state of the wrong directory.
+func Traceable(filter core.TraverseFilter, order enums.TraversalOrder) error {
+	if order == enums.TraversalOrderBreadthFirst && tracks(filter) {
+		return locale.ErrFilterTrackerBreadthFirstNotSupported
+	}
+
+	return nil
+}
+
+// tracks determines whether the filter, or any of its constituents, follows
+// the progress of navigation.
+func tracks(filter core.TraverseFilter) bool {
+	switch f := filter.(type) {
+	case *Compound:
+		for _, constituent := range f.filters {
+			if tracks(constituent) {
+				return true
+			}
+		}
+
+		return false
+
+	case Tracker:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package kernel
+
+import (
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	nef "github.com/snivilised/nefilim"
+)
+
+// Surveyor acquires the status and contents of a directory, via the
+// QueryStatus and ReadDirectory hooks, so that surveying is subject to
+// the throttle, as is navigation.
+type Surveyor interface {
+	Query(path string) (fs.FileInfo, error)
+	Read(path string) ([]fs.DirEntry, error)
+}
+
+// Ancestry returns the fingerprint of the directories from the tree down to
+// the parent of the current path, so that on resume, it can be determined
+// whether the tree has drifted since the navigation state was saved. A
+// directory that can not be read, or whose read is abandoned by the throttle,
+// is omitted.
+func Ancestry(s Surveyor, calc nef.PathCalc, tree, current string) []core.Landmark {
+	landmarks := []core.Landmark{}
+
+	for _, path := range lineage(calc, tree, current) {
+		if landmark, err := Survey(s, path); err == nil {
+			landmarks = append(landmarks, *landmark)
+		}
+	}
+
+	return landmarks
+}
+
+// Survey returns the landmark of the directory at path, as it is now.
+func Survey(s Surveyor, path string) (*core.Landmark, error) {
+	info, err := s.Query(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.Landmark{
+		Path:     path,
+		Modified: info.ModTime(),
+		Entries:  len(entries),
+	}, nil
+}
+
+// lineage returns the paths of the directories from the tree down to the
+// parent of the current path.
+func lineage(calc nef.PathCalc, tree, current string) []string {
+	if current == "" || current == tree || !strings.HasPrefix(current, tree) {
+		return []string{tree}
+	}
+
+	paths := []string{}
+
+	for path := calc.Dir(current); len(path) > len(tree); {
+		paths = append(paths, path)
+
+		parent := calc.Dir(path)
+		if parent == path {
+			break
+		}
+
+		path = parent
+	}
+
+	paths = append(paths, tree)
+	slices.Reverse(paths)
+
+	return paths
+}
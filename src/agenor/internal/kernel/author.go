@@ -1,9 +1,6 @@
 package kernel
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
@@ -19,20 +16,35 @@ func (a *author) write(vex vexation) (string, error) {
 	vapour := vex.vapour()
 	static := vapour.static()
 	forest := static.mediator.resources.Forest
+	active := vapour.active(vex.ancestor(), forest,
+		vex.level(),
+		static.mediator.metrics,
+	)
+	active.Frontier = vex.frontier()
+	active.Ancestry = Ancestry(static.mediator, forest.T.Calc(),
+		active.Tree, active.CurrentPath,
+	)
+
+	return a.save(forest, active, vex.magnitude(), vex.cause())
+}
+
+// save writes the active state to the resume file system. The ancestry of
+// the current path, by which tree drift is detected on resume, must already
+// have been surveyed on the navigation go-routine, so that it is subject to
+// the throttle.
+func (a *author) save(forest *core.Forest,
+	active *core.ActiveState,
+	magnitude, cause string,
+) (string, error) {
 	fS := forest.R
 	calc := fS.Calc()
-	directory, file := a.destination(vex, calc)
+	directory, file := a.destination(magnitude, cause, calc)
 
 	if err := fS.MakeDirAll(directory, a.perms.Dir); err != nil {
 		return "", err
 	}
 
 	path := calc.Join(directory, file)
-	active := vapour.active(vex.ancestor(), forest,
-		static.mediator.periscope.Depth(),
-		static.mediator.metrics,
-	)
-
 	request := &persist.MarshalRequest{
 		Active: active,
 		O:      a.o,
@@ -46,19 +58,11 @@ func (a *author) write(vex vexation) (string, error) {
 	return path, err
 }
 
-func (a *author) destination(v vexation, calc nef.PathCalc) (directory, file string) {
-	mag := v.magnitude()
-	cause := v.cause()
-	now := core.Now()
-
-	directory = nef.ResolvePath(a.o.Monitor.Admin.Path)
-	if !strings.HasSuffix(directory, core.ResumeTail) {
-		directory = calc.Join(directory, core.ResumeTail)
-	}
-
-	file = fmt.Sprintf("%v.%v.%v.json",
-		mag, cause, now.Format(core.FileSystemTimeFormat),
-	)
+func (a *author) destination(magnitude, cause string,
+	calc nef.PathCalc,
+) (directory, file string) {
+	directory = persist.ResumeDirectory(a.o.Monitor.Admin.Path, calc)
+	file = persist.CheckpointName(magnitude, cause, core.Now(), a.o.Persist.Format)
 
 	return directory, file
 }
@@ -0,0 +1,194 @@
+package kernel
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/stock"
+)
+
+const checkpointCause = "checkpoint"
+
+type (
+	// checkpointer periodically saves the navigation state, so that navigation
+	// can be resumed even when the process is terminated without the opportunity
+	// to rescue a panic. The state is captured on the navigation go-routine just
+	// prior to a node being invoked, but is written on a separate go-routine so
+	// that navigation is not held up. On resume, the node that was about to be
+	// invoked is visited again, ie no node is missed, but a node may be invoked
+	// more than once.
+	checkpointer struct {
+		o         *pref.CheckpointOptions
+		persister *author
+		forest    *core.Forest
+		magnitude string
+		log       *slog.Logger
+		count     uint
+		last      time.Time
+		queue     chan *core.ActiveState
+		done      chan struct{}
+		written   []string
+	}
+
+	// remover is implemented by file systems that are able to delete
+	// files, which is required in order to rotate checkpoints.
+	remover interface {
+		Remove(name string) error
+	}
+)
+
+func newCheckpointer(o *pref.Options, inception *Inception) *checkpointer {
+	if !o.Monitor.Checkpoint.IsActive() {
+		return nil
+	}
+
+	return &checkpointer{
+		o: &o.Monitor.Checkpoint,
+		persister: &author{
+			o:     o,
+			perms: core.Perms,
+		},
+		forest:    inception.Resources.Forest,
+		magnitude: inception.Facade.Magnitude(),
+		log:       o.Monitor.Log,
+	}
+}
+
+// tick is invoked prior to the invocation of each node and captures the
+// navigation state when a checkpoint is due.
+func (c *checkpointer) tick(i enclave.Inspection) {
+	if c.last.IsZero() {
+		c.last = time.Now()
+	}
+
+	if vapour, ok := i.(inspection); ok && c.due() {
+		c.capture(vapour)
+		c.count = 0
+		c.last = time.Now()
+	}
+
+	c.count++
+}
+
+func (c *checkpointer) due() bool {
+	return (c.o.Nodes > 0 && c.count >= c.o.Nodes) ||
+		(c.o.Interval > 0 && time.Since(c.last) >= c.o.Interval)
+}
+
+// capture takes a snapshot of the navigation state, with the current node
+// as the point of resumption, and hands it over to be written. The depth
+// is that of a directory at the same level as the current node, since a
+// file does not result in the periscope descending.
+func (c *checkpointer) capture(vapour inspection) {
+	var (
+		static  = vapour.static()
+		current = vapour.Current()
+		depth   = static.mediator.periscope.Depth()
+	)
+
+	if !current.IsDirectory() {
+		depth++
+	}
+
+	active := vapour.active(static.mediator.tree,
+		static.mediator.resources.Forest,
+		depth,
+		static.mediator.metrics.Clone(),
+	)
+	active.Ancestry = Ancestry(static.mediator,
+		static.mediator.resources.Forest.T.Calc(),
+		active.Tree, active.CurrentPath,
+	)
+
+	if static.frontier != nil {
+		active.Frontier = append([]string{current.Path},
+			static.frontier.paths(static.frontier.size())...,
+		)
+	}
+
+	c.post(active)
+}
+
+// post hands over the state to the writer, which is started on demand. If
+// the previous checkpoint has not yet been written, it is superseded.
+func (c *checkpointer) post(active *core.ActiveState) {
+	if c.queue == nil {
+		c.queue = make(chan *core.ActiveState, 1)
+		c.done = make(chan struct{})
+
+		go c.scribe()
+	}
+
+	select {
+	case c.queue <- active:
+	default:
+		select {
+		case <-c.queue:
+		default:
+		}
+
+		c.queue <- active
+	}
+}
+
+func (c *checkpointer) scribe() {
+	defer close(c.done)
+
+	for active := range c.queue {
+		path, err := c.persister.save(c.forest, active, c.magnitude, checkpointCause)
+		if err != nil {
+			c.log.Error(err.Error())
+
+			continue
+		}
+
+		c.rotate(path)
+	}
+}
+
+// rotate records the checkpoint written to path and removes the oldest
+// checkpoints in excess of the number to be retained.
+func (c *checkpointer) rotate(path string) {
+	if n := len(c.written); n == 0 || c.written[n-1] != path {
+		c.written = append(c.written, path)
+	}
+
+	keep := max(int(c.o.Keep), 1) //nolint:gosec // ok
+
+	for len(c.written) > keep {
+		c.remove(c.written[0])
+		c.written = c.written[1:]
+	}
+}
+
+func (c *checkpointer) remove(path string) {
+	if r, ok := c.forest.R.(remover); ok {
+		if err := r.Remove(path); err != nil {
+			c.log.Error(err.Error())
+		}
+	}
+}
+
+// conclude waits for the outstanding checkpoint to be written. If navigation
+// completed successfully, the checkpoints are no longer of any use, so they
+// are removed.
+func (c *checkpointer) conclude(err error) {
+	if c.queue != nil {
+		close(c.queue)
+		<-c.done
+		c.queue = nil
+	}
+
+	if !stock.IsBenignError(err) {
+		return
+	}
+
+	for _, path := range c.written {
+		c.remove(path)
+	}
+
+	c.written = nil
+}
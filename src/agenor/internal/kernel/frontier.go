@@ -0,0 +1,62 @@
+package kernel
+
+import (
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/third/lo"
+)
+
+type (
+	// pending is a node that has been discovered, but is yet to be visited,
+	// when navigating breadth first. The level is the raw depth of the
+	// periscope at the point the node was discovered, which is restored
+	// when it is visited.
+	pending struct {
+		node  *core.Node
+		level core.TraversalDepth
+	}
+
+	// frontier is the queue of pending nodes, in level order. Since every
+	// node of a directory is queued after all the nodes of the previous
+	// depth, popping from the front results in a level order traversal.
+	frontier struct {
+		queue []*pending
+	}
+)
+
+func (f *frontier) push(node *core.Node, level core.TraversalDepth) {
+	f.queue = append(f.queue, &pending{
+		node:  node,
+		level: level,
+	})
+}
+
+func (f *frontier) pop() (*pending, bool) {
+	if len(f.queue) == 0 {
+		return nil, false
+	}
+
+	item := f.queue[0]
+	f.queue[0] = nil
+	f.queue = f.queue[1:]
+
+	return item, true
+}
+
+func (f *frontier) size() int {
+	return len(f.queue)
+}
+
+// drop discards the pending siblings of a node, ie the remaining nodes
+// whose parent is the one specified.
+func (f *frontier) drop(parent *core.Node) {
+	f.queue = lo.Reject(f.queue, func(item *pending, _ int) bool {
+		return item.node.Parent == parent
+	})
+}
+
+// paths returns the paths of the first n pending nodes
+func (f *frontier) paths(n int) []string {
+	return lo.Map(f.queue[:min(n, len(f.queue))], func(item *pending, _ int) string {
+		return item.node.Path
+	})
+}
@@ -36,6 +36,7 @@ type anchor struct {
 	subscription enums.Subscription
 	client       core.Client
 	crate        enclave.Crate
+	throttle     *throttle
 }
 
 // Next determines whether the servant should be filtered out or not, and
@@ -49,6 +50,22 @@ func (a *anchor) Next(servant core.Servant, _ enclave.Inspection) (bool, error)
 		metric.Tick()
 	}
 
+	if !node.IsDirectory() && node.Info != nil {
+		size := core.MetricValue(node.Info.Size()) //nolint:gosec // ok
+
+		if metric := a.crate.Metrics[enums.MetricNoFileBytesInvoked]; metric != nil {
+			metric.Times(size)
+		}
+
+		if metric := a.crate.Metrics[enums.MetricLargestFileSize]; metric != nil {
+			metric.Peak(size)
+		}
+	}
+
+	if err := a.throttle.present(); err != nil {
+		return false, err
+	}
+
 	return false, a.client(servant)
 }
 
@@ -57,7 +74,29 @@ func (a *anchor) Role() enums.Role {
 	return enums.RoleAnchor
 }
 
-// swap replaces the client with a new one.
+// instrument decorates the client, so that the bytes of client I/O are
+// paced and the duration of the callback is recorded into the timer.
+// As the decorated client is what a swap returns, this still applies
+// when the callback is invoked on another go-routine, as it is by a
+// Sprint.
+func instrument(client core.Client,
+	timer *core.LatencyHistogram,
+	throttle *throttle,
+) core.Client {
+	return func(servant core.Servant) error {
+		if err := throttle.transfer(servant.Node()); err != nil {
+			return err
+		}
+
+		stop := clock(timer)
+		defer stop()
+
+		return client(servant)
+	}
+}
+
+// swap replaces the client with a new one, returning the client
+// being replaced.
 func (a *anchor) swap(decorator core.Client) core.Client {
 	swap := a.client
 	a.client = decorator
@@ -77,6 +116,8 @@ type guardianInfo struct {
 	client       core.Client
 	master       enclave.GuardianSealer
 	metrics      core.Metrics
+	timer        *core.LatencyHistogram
+	throttle     *throttle
 }
 
 func newGuardian(info *guardianInfo) *guardian {
@@ -87,10 +128,11 @@ func newGuardian(info *guardianInfo) *guardian {
 		master: info.master,
 		anchor: &anchor{
 			subscription: info.subscription,
-			client:       info.client,
+			client:       instrument(info.client, info.timer, info.throttle),
 			crate: enclave.Crate{
 				Metrics: info.metrics,
 			},
+			throttle: info.throttle,
 		},
 	}
 }
@@ -172,9 +214,10 @@ func (g *guardian) iterate(servant core.Servant, inspection enclave.Inspection)
 	return nil
 }
 
-// Swap replaces the client with a new one.
-func (g *guardian) Swap(decorator core.Client) {
-	_ = g.anchor.swap(decorator)
+// Swap replaces the client with a new one, returning the client
+// being replaced.
+func (g *guardian) Swap(decorator core.Client) core.Client {
+	return g.anchor.swap(decorator)
 }
 
 // Benign is used when a master sealer has not been registered. It is
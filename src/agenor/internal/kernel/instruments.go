@@ -0,0 +1,46 @@
+package kernel
+
+import (
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+)
+
+// instruments contains the histograms into which the durations of the
+// operations being measured are recorded. Each is nil, unless
+// instrumentation is enabled.
+type instruments struct {
+	read   *core.LatencyHistogram
+	status *core.LatencyHistogram
+	client *core.LatencyHistogram
+}
+
+func newInstruments(o *pref.Options, supervisor *enclave.Supervisor) *instruments {
+	if !o.Monitor.Instrument {
+		return &instruments{}
+	}
+
+	return &instruments{
+		read:   supervisor.Timer(enums.InstrumentReadDirectory),
+		status: supervisor.Timer(enums.InstrumentQueryStatus),
+		client: supervisor.Timer(enums.InstrumentClient),
+	}
+}
+
+// clock starts timing an operation, returning the function that records
+// its duration into the histogram. When the histogram is nil, the time is
+// not queried and the function returned does nothing.
+func clock(histogram *core.LatencyHistogram) func() {
+	if histogram == nil {
+		return func() {}
+	}
+
+	started := time.Now()
+
+	return func() {
+		histogram.Record(time.Since(started))
+	}
+}
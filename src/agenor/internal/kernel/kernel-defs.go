@@ -28,6 +28,12 @@ type (
 			servant core.Servant,
 		) (bool, error)
 
+		// Sweep resumes a breadth first traversal from the frontier.
+		Sweep(ctx context.Context,
+			ns *navigationStatic,
+			paths []string,
+		) (*enclave.KernelResult, error)
+
 		// Result returns the result of the traversal.
 		Result(ctx context.Context) *enclave.KernelResult
 	}
@@ -100,6 +106,7 @@ type (
 		calc         nef.PathCalc
 		magnitude    string
 		subscription enums.Subscription
+		frontier     *frontier
 	}
 
 	inspection interface { // after content has been read
@@ -0,0 +1,195 @@
+package kernel
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+)
+
+type (
+	// linkEntry represents a symbolic link to a directory that is being
+	// followed. It assumes the type and info of the link's target, so that
+	// it is sorted and navigated as a directory, but retains the link's own
+	// name.
+	linkEntry struct {
+		fs.DirEntry
+		info   fs.FileInfo
+		target string
+	}
+
+	// linker applies the link behaviour to the entries of a directory.
+	linker struct {
+		mode enums.LinkMode
+	}
+)
+
+func (e *linkEntry) IsDir() bool {
+	return e.info.IsDir()
+}
+
+func (e *linkEntry) Type() fs.FileMode {
+	return e.info.Mode().Type()
+}
+
+func (e *linkEntry) Info() (fs.FileInfo, error) {
+	return e.info, nil
+}
+
+func isLink(entry fs.DirEntry) bool {
+	return entry.Type()&fs.ModeSymlink != 0
+}
+
+// resolve removes links from entries when they are to be ignored, or
+// substitutes links to directories with a linkEntry when they are to be
+// followed. A link is only followed if it can be checked for cycles; ie
+// either the identity of its target is known, or the link can be read.
+func (l *linker) resolve(sys fs.ReadDirFS, parent string,
+	entries []fs.DirEntry,
+) []fs.DirEntry {
+	switch l.mode {
+	case enums.LinkModeIgnore:
+		result := make([]fs.DirEntry, 0, len(entries))
+
+		for _, entry := range entries {
+			if !isLink(entry) {
+				result = append(result, entry)
+			}
+		}
+
+		return result
+
+	case enums.LinkModeFollow:
+		for i, entry := range entries {
+			if !isLink(entry) {
+				continue
+			}
+
+			path := filepath.Join(parent, entry.Name())
+			target, info, err := follow(sys, path)
+
+			if err != nil || !info.IsDir() {
+				continue
+			}
+
+			if _, known := tfs.IdentityOf(info); known || target != "" {
+				entries[i] = &linkEntry{
+					DirEntry: entry,
+					info:     info,
+					target:   target,
+				}
+			}
+		}
+
+	case enums.LinkModeUndefined, enums.LinkModeReport:
+	}
+
+	return entries
+}
+
+// maxHops is the maximum number of links that are followed in order to
+// reach the entity that a link ultimately refers to.
+const maxHops = 40
+
+// follow reads the link at path and returns its target along with the
+// info of the entity that it ultimately refers to. The file system's Stat
+// can not be relied upon to follow links (on the host file system it does
+// not), so each link in the chain is read explicitly, with targets being
+// resolved relative to the directory containing the link.
+func follow(sys fs.ReadDirFS, path string) (string, fs.FileInfo, error) {
+	target, err := fs.ReadLink(sys, path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	current := path
+	next := target
+
+	for range maxHops {
+		current = destination(filepath.Dir(current), next)
+
+		info, err := fs.Lstat(sys, current)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if info.Mode()&fs.ModeSymlink == 0 {
+			return target, info, nil
+		}
+
+		if next, err = fs.ReadLink(sys, current); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return "", nil, &fs.PathError{Op: "follow", Path: path, Err: syscall.ELOOP}
+}
+
+// target returns the target of the link represented by entry, or empty
+// string if entry is not a link.
+func (l *linker) target(sys fs.ReadDirFS, path string, entry fs.DirEntry) string {
+	if l.mode == enums.LinkModeIgnore {
+		return ""
+	}
+
+	if link, ok := entry.(*linkEntry); ok {
+		return link.target
+	}
+
+	if !isLink(entry) {
+		return ""
+	}
+
+	target, _ := fs.ReadLink(sys, path)
+
+	return target
+}
+
+// cyclic determines whether the directory that a followed link resolves
+// to is the parent or one of its ancestors, in which case following the
+// link would result in infinite recursion. The device and inode are used
+// when available, otherwise the real paths (with any previously followed
+// links resolved) are compared.
+func cyclic(parent *core.Node, link *linkEntry) bool {
+	if id, ok := tfs.IdentityOf(link.info); ok {
+		for ancestor := parent; ancestor != nil; ancestor = ancestor.Parent {
+			if other, known := tfs.IdentityOf(ancestor.Info); known && other == id {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	from := realPath(parent)
+	to := destination(from, link.target)
+
+	return from == to || strings.HasPrefix(from, to+string(filepath.Separator))
+}
+
+// realPath returns the path of node with any followed links resolved.
+func realPath(node *core.Node) string {
+	if node.Parent == nil {
+		return node.Path
+	}
+
+	dir := realPath(node.Parent)
+
+	if node.Link != "" && node.IsDirectory() {
+		return destination(dir, node.Link)
+	}
+
+	return filepath.Join(dir, filepath.Base(node.Path))
+}
+
+func destination(dir, target string) string {
+	if filepath.IsAbs(target) {
+		return filepath.Clean(target)
+	}
+
+	return filepath.Join(dir, target)
+}
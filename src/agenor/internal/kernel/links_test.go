@@ -0,0 +1,188 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Links", Ordered, func() {
+	const (
+		tree = "links"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		link := func(target string) *fstest.MapFile {
+			return &fstest.MapFile{
+				Data: []byte(target),
+				Mode: fs.ModeSymlink,
+			}
+		}
+
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"links":                {Mode: fs.ModeDir | lab.Perms.Dir},
+				"links/alpha":          {Mode: fs.ModeDir | lab.Perms.Dir},
+				"links/alpha/a.txt":    {Mode: lab.Perms.File},
+				"links/alpha/loop":     link(".."),
+				"links/beta":           {Mode: fs.ModeDir | lab.Perms.Dir},
+				"links/beta/b.txt":     {Mode: lab.Perms.File},
+				"links/beta/to-alpha":  link("../alpha"),
+				"links/shortcut-b.txt": link("beta/b.txt"),
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	type visit struct {
+		visited map[string]string
+		cycles  []string
+	}
+
+	navigate := func(ctx context.Context,
+		tS tfs.TraversalFS, root string, mode enums.LinkMode,
+	) *visit {
+		result := &visit{
+			visited: make(map[string]string),
+		}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         root,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						node := servant.Node()
+						result.visited[node.Path] = node.Link
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: tS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			agenor.WithLinkBehaviour(&pref.LinkBehaviour{
+				Mode: mode,
+			}),
+			agenor.WithOnCycle(func(node *core.Node) {
+				result.cycles = append(result.cycles, node.Path)
+			}),
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return result
+	}
+
+	walk := func(ctx context.Context, mode enums.LinkMode) *visit {
+		return navigate(ctx, fS, tree, mode)
+	}
+
+	When("mode is report", func() {
+		It("🧪 should: deliver links with their targets, without following", func(ctx SpecContext) {
+			result := walk(ctx, enums.LinkModeReport)
+
+			Expect(result.visited).To(HaveKeyWithValue("links/alpha/loop", ".."))
+			Expect(result.visited).To(HaveKeyWithValue("links/beta/to-alpha", "../alpha"))
+			Expect(result.visited).To(HaveKeyWithValue("links/shortcut-b.txt", "beta/b.txt"))
+			Expect(result.visited).To(HaveKeyWithValue("links/alpha/a.txt", ""))
+			Expect(result.visited).NotTo(HaveKey("links/beta/to-alpha/a.txt"))
+			Expect(result.cycles).To(BeEmpty())
+		})
+	})
+
+	When("mode is undefined", func() {
+		It("🧪 should: behave as report", func(ctx SpecContext) {
+			Expect(walk(ctx, enums.LinkModeUndefined)).To(Equal(
+				walk(ctx, enums.LinkModeReport),
+			))
+		})
+	})
+
+	When("mode is ignore", func() {
+		It("🧪 should: not deliver links", func(ctx SpecContext) {
+			result := walk(ctx, enums.LinkModeIgnore)
+
+			Expect(result.visited).To(HaveKey("links/alpha/a.txt"))
+			Expect(result.visited).NotTo(HaveKey("links/alpha/loop"))
+			Expect(result.visited).NotTo(HaveKey("links/beta/to-alpha"))
+			Expect(result.visited).NotTo(HaveKey("links/shortcut-b.txt"))
+			Expect(result.cycles).To(BeEmpty())
+		})
+	})
+
+	When("mode is follow", func() {
+		It("🧪 should: navigate linked directories and not follow cycles", func(ctx SpecContext) {
+			result := walk(ctx, enums.LinkModeFollow)
+
+			Expect(result.visited).To(HaveKeyWithValue("links/beta/to-alpha", "../alpha"))
+			Expect(result.visited).To(HaveKey("links/beta/to-alpha/a.txt"))
+			Expect(result.visited).To(HaveKeyWithValue("links/shortcut-b.txt", "beta/b.txt"))
+			Expect(result.visited).NotTo(HaveKey("links/alpha/loop/alpha"))
+			Expect(result.cycles).To(ConsistOf(
+				"links/alpha/loop",
+				"links/beta/to-alpha/loop",
+			))
+		})
+	})
+
+	When("mode is follow and tree is on the host file system", func() {
+		It("🧪 should: navigate linked directories and not follow cycles", func(ctx SpecContext) {
+			root := filepath.Join(GinkgoT().TempDir(), tree)
+
+			Expect(os.MkdirAll(filepath.Join(root, "alpha"), lab.Perms.Dir)).To(Succeed())
+			Expect(os.MkdirAll(filepath.Join(root, "beta"), lab.Perms.Dir)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(root, "alpha", "a.txt"), nil, lab.Perms.File)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(root, "beta", "b.txt"), nil, lab.Perms.File)).To(Succeed())
+			Expect(os.Symlink("..", filepath.Join(root, "alpha", "loop"))).To(Succeed())
+			Expect(os.Symlink("../alpha", filepath.Join(root, "beta", "to-alpha"))).To(Succeed())
+
+			result := navigate(ctx, tfs.New(), root, enums.LinkModeFollow)
+			at := func(parts ...string) string {
+				return filepath.Join(append([]string{root}, parts...)...)
+			}
+
+			Expect(result.visited).To(HaveKeyWithValue(at("beta", "to-alpha"), "../alpha"))
+			Expect(result.visited).To(HaveKey(at("beta", "to-alpha", "a.txt")))
+			Expect(result.visited).NotTo(HaveKey(at("alpha", "loop", "alpha")))
+			Expect(result.cycles).To(ConsistOf(
+				at("alpha", "loop"),
+				at("beta", "to-alpha", "loop"),
+			))
+		})
+	})
+})
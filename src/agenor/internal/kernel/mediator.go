@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"path/filepath"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
 	"github.com/snivilised/jaywalk/src/agenor/internal/level"
 	"github.com/snivilised/jaywalk/src/agenor/life"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/jaywalk/src/agenor/stock"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
 )
 
 // mediator controls traversal, sends notifications and emits
@@ -27,6 +32,11 @@ type mediator struct {
 	resources    *enclave.Resources
 	metrics      core.Metrics
 	order        []enums.Role
+	device       *uint64
+	pruner       core.TraverseFilter
+	checkpoint   *checkpointer
+	timers       *instruments
+	throttle     *throttle
 }
 
 // NewMediator creates new Mediator
@@ -36,14 +46,26 @@ func NewMediator(inception *Inception,
 	o := inception.Harvest.Options()
 	facade := inception.Facade
 	resources := inception.Resources
-	impl, err := newImpl(o, inception)
+	timers := newInstruments(o, resources.Supervisor)
+	limiter := newThrottle(o, resources.Supervisor)
+	impl, err := newImpl(o, inception, timers, limiter)
 
 	metrics := resources.Supervisor.Many(
 		enums.MetricNoFilesInvoked,
 		enums.MetricNoDirectoriesInvoked,
 		enums.MetricNoChildFilesFound,
+		enums.MetricNoMountPointsSkipped,
+		enums.MetricNoDirectoriesPruned,
+		enums.MetricNoFileBytesInvoked,
+		enums.MetricLargestFileSize,
+		enums.MetricDeepestDepth,
 	)
 
+	pruner, pe := newPruner(o, resources)
+	if err == nil {
+		err = pe
+	}
+
 	return &mediator{
 		tree:         inception.NavigationTree(),
 		subscription: inception.Subscription,
@@ -54,14 +76,49 @@ func NewMediator(inception *Inception,
 			client:       facade.Client(),
 			master:       sealer,
 			metrics:      metrics,
+			timer:        timers.client,
+			throttle:     limiter,
 		}),
-		periscope: level.New(),
-		o:         o,
-		resources: resources,
-		metrics:   metrics,
+		periscope:  level.New(),
+		o:          o,
+		resources:  resources,
+		metrics:    metrics,
+		pruner:     pruner,
+		checkpoint: newCheckpointer(o, inception),
+		timers:     timers,
+		throttle:   limiter,
 	}, err
 }
 
+// newPruner creates the filter that determines which directories are not
+// descended, if pruning is active.
+func newPruner(o *pref.Options, resources *enclave.Resources) (core.TraverseFilter, error) {
+	if !o.Filter.IsPruningActive() {
+		return nil, nil
+	}
+
+	pruner, err := filtering.New(o.Filter.Prune, &pref.FilterOptions{
+		Node: o.Filter.Prune,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if pruner == nil {
+		return nil, locale.ErrMissingCustomFilterDefinition
+	}
+
+	if err := filtering.Traceable(pruner, o.Behaviours.Order); err != nil {
+		return nil, err
+	}
+
+	if tracker, ok := pruner.(filtering.Tracker); ok {
+		tracker.Track(resources.Forest, resources.Binder.Controls)
+	}
+
+	return pruner, nil
+}
+
 // Decorate adds a decorator to the invocation chain. The order of decoration is
 // important, as it determines the order in which the decorators are invoked.
 // For example, if a filter is decorated before a master sealer, then the filter
@@ -80,9 +137,10 @@ func (m *mediator) Unwind(role enums.Role) error {
 
 // Swap replaces the underlying client handler with the provided decorator. This
 // is used to allow the guardian to be decorated with different handlers, such as
-// filters or a master sealer.
-func (m *mediator) Swap(decorator core.Client) {
-	m.guardian.Swap(decorator)
+// filters or a master sealer. The handler being replaced is returned, so that
+// the decorator can delegate to it.
+func (m *mediator) Swap(decorator core.Client) core.Client {
+	return m.guardian.Swap(decorator)
 }
 
 // Invoke executes the chain which may or may not end up resulting in
@@ -91,6 +149,10 @@ func (m *mediator) Swap(decorator core.Client) {
 func (m *mediator) Invoke(servant core.Servant,
 	inspection enclave.Inspection,
 ) error {
+	if m.checkpoint != nil {
+		m.checkpoint.tick(inspection)
+	}
+
 	return m.guardian.Invoke(servant, inspection)
 }
 
@@ -125,11 +187,25 @@ func (m *mediator) Arrange(active, order []enums.Role) {
 	m.guardian.arrange(active, order)
 }
 
-// Read acquires the contents of a directory
+// Read acquires the contents of a directory via the ReadDirectory hook,
+// subject to the throttle.
 func (m *mediator) Read(path string) ([]fs.DirEntry, error) {
+	if err := m.throttle.read(); err != nil {
+		return nil, err
+	}
+
+	stop := clock(m.timers.read)
+	defer stop()
+
 	return m.o.Hooks.ReadDirectory.Invoke()(m.resources.Forest.T, path)
 }
 
+// Query gets the status of the entity at the specified path via the
+// QueryStatus hook.
+func (m *mediator) Query(path string) (fs.FileInfo, error) {
+	return query(m.resources.Forest.T, &m.o.Hooks, m.timers.status, path)
+}
+
 // Spawn allows the mediator to spawn a new child navigation with the specified
 // tree. This is used by the guardian to spawn a new child navigation when a
 // new session is started or when a session is resumed, which allows the kernel
@@ -147,12 +223,42 @@ func (m *mediator) Spawn(ctx context.Context,
 	})
 }
 
+// Sweep resumes a breadth first navigation from the frontier of nodes
+// that were pending when the previous navigation was interrupted.
+func (m *mediator) Sweep(ctx context.Context,
+	frontier []string,
+) (*enclave.KernelResult, error) {
+	return m.impl.Sweep(ctx, &navigationStatic{
+		mediator:     m,
+		tree:         m.tree,
+		calc:         m.resources.Forest.T.Calc(),
+		subscription: m.subscription,
+		magnitude:    m.facade.Magnitude(),
+	}, frontier)
+}
+
 // Bridge combines information gleaned from the previous traversal that was
 // interrupted, into the resume traversal
 func (m *mediator) Bridge(active *core.ActiveState) {
 	m.tree = active.Tree
 	m.periscope.Offset(active.Depth)
 	m.Supervisor().Load(active.Metrics)
+
+	// a resume may navigate a sub-tree, whose root does not necessarily
+	// reside on the same device as the tree.
+	//
+	if m.o.Behaviours.Cascade.OneFileSystem {
+		if info, err := m.Query(active.Tree); err == nil {
+			m.identify(info)
+		}
+	}
+
+	if active.Hibernation != enums.HibernationUndefined {
+		m.resources.Hibernation.State = active.Hibernation
+	}
+
+	m.resources.Hibernation.Seen = active.HibernationSeen
+	m.resources.Hibernation.Invoked = active.HibernationInvoked
 }
 
 // Supervisor gets the supervisor from the resources
@@ -178,6 +284,16 @@ func (m *mediator) Navigate(ctx context.Context) (result *enclave.KernelResult,
 	return result, err
 }
 
+// Conclude is invoked once navigation has finished, including all the
+// navigations required by resume, with the error it finished with. Any
+// outstanding checkpoint is written and if the navigation was successful,
+// the checkpoints are removed.
+func (m *mediator) Conclude(err error) {
+	if m.checkpoint != nil {
+		m.checkpoint.conclude(err)
+	}
+}
+
 // Ignite primes the navigator for traversal and announces
 func (m *mediator) Ignite(ignition *enclave.Ignition) {
 	m.impl.Ignite(ignition)
@@ -212,15 +328,100 @@ func (m *mediator) Bye(result core.TraverseResult) {
 }
 
 func (m *mediator) descend(node *core.Node) bool {
+	if m.pruned(node) {
+		m.metrics[enums.MetricNoDirectoriesPruned].Tick()
+
+		return false
+	}
+
 	if !m.periscope.Descend(m.o.Behaviours.Cascade.Depth) {
 		return false
 	}
 
+	m.metrics[enums.MetricDeepestDepth].Peak(core.MetricValue(m.periscope.Depth()))
+
 	m.resources.Binder.Controls.Descend.Dispatch()(node)
 
 	return true
 }
 
+// mounted determines whether the directory node is a mount point, ie a
+// directory that resides on a different device to the tree, when navigation
+// is confined to a single file system. As with find -xdev, a mount point is
+// still delivered to the client, but its contents are not read.
+func (m *mediator) mounted(node *core.Node) bool {
+	if !m.o.Behaviours.Cascade.OneFileSystem {
+		return false
+	}
+
+	// unless already established by Bridge, the device of the tree is
+	// acquired from the top node.
+	//
+	if m.device == nil && node.Parent == nil {
+		m.identify(node.Info)
+
+		return false
+	}
+
+	if !m.foreign(node.Info) {
+		return false
+	}
+
+	m.metrics[enums.MetricNoMountPointsSkipped].Tick()
+
+	return true
+}
+
+// identify records the device of the tree described by info.
+func (m *mediator) identify(info fs.FileInfo) {
+	if id, known := tfs.IdentityOf(info); known {
+		m.device = &id.Device
+	}
+}
+
+// pruned determines whether the directory node matches the prune filter.
+// Since the directory has not yet been read, the filter is applied to
+// a provisional copy of the node, so that the node itself is left intact
+// until it is extended.
+func (m *mediator) pruned(node *core.Node) bool {
+	if m.pruner == nil || node.Parent == nil {
+		return false
+	}
+
+	depth := m.periscope.Depth() + 1
+	parent, name := filepath.Split(node.Path)
+	provisional := *node
+	provisional.Extension = core.Extension{
+		Depth:  depth,
+		Name:   name,
+		Parent: parent,
+		Scope: lo.Ternary(depth == 1,
+			enums.ScopeTop, enums.ScopeIntermediate,
+		) | enums.ScopeDirectory,
+	}
+
+	return m.pruner.IsApplicable(&provisional) && m.pruner.IsMatch(&provisional)
+}
+
+// foreign determines whether the entity described by info resides on a
+// different device to the tree. Always false, unless navigation is
+// confined to a single file system.
+func (m *mediator) foreign(info fs.FileInfo) bool {
+	if m.device == nil {
+		return false
+	}
+
+	id, known := tfs.IdentityOf(info)
+
+	return known && id.Device != *m.device
+}
+
+// deeper determines whether the children of the current directory
+// are within the maximum depth, ie whether they will be descended.
+func (m *mediator) deeper() bool {
+	return m.periscope.Permits(m.o.Behaviours.Cascade.Depth)
+}
+
 func (m *mediator) ascend(node *core.Node, permit bool) {
 	if permit {
 		m.periscope.Ascend()
@@ -2,10 +2,14 @@ package kernel
 
 import (
 	"io/fs"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/tapable"
 )
 
 func read(sys fs.ReadDirFS, o *readOptions, path string) (*Contents, error) {
-	entries, err := o.hooks.read.Invoke()(sys, path)
+	entries, err := o.entries(sys, path)
+	entries = o.links.resolve(sys, path, entries)
 
 	contents := NewContents(
 		o.behaviour, o.hooks.sort, entries,
@@ -13,3 +17,35 @@ func read(sys fs.ReadDirFS, o *readOptions, path string) (*Contents, error) {
 
 	return contents, err
 }
+
+// entries reads the contents of the directory at path, using the prefetched
+// result if the directory has been read ahead.
+func (o *readOptions) entries(sys fs.ReadDirFS, path string) ([]fs.DirEntry, error) {
+	if o.ahead != nil {
+		if job := o.ahead.take(path); job != nil {
+			return job.entries, job.err
+		}
+	}
+
+	if err := o.throttle.read(); err != nil {
+		return nil, err
+	}
+
+	stop := clock(o.timer)
+	defer stop()
+
+	return o.hooks.read.Invoke()(sys, path)
+}
+
+// query gets the status of the entity at path via the QueryStatus hook,
+// recording the duration of every invocation into the histogram.
+func query(sys fs.StatFS,
+	hooks *tapable.Hooks,
+	timer *core.LatencyHistogram,
+	path string,
+) (fs.FileInfo, error) {
+	stop := clock(timer)
+	defer stop()
+
+	return hooks.QueryStatus.Invoke()(sys, path)
+}
@@ -55,12 +55,14 @@ func (v *navigationVapour) active(tree string,
 		ResumeDescription: core.FsDescription{
 			IsRelative: forest.R.IsRelative(),
 		},
-		Subscription: v.ns.subscription,
-		Hibernation:  enums.HibernationRetired, // TODO:check
-		CurrentPath:  v.present.Path,
-		IsDir:        v.present.IsDirectory(),
-		Depth:        depth,
-		Metrics:      metrics,
+		Subscription:       v.ns.subscription,
+		Hibernation:        v.ns.mediator.resources.Hibernation.State,
+		HibernationSeen:    v.ns.mediator.resources.Hibernation.Seen,
+		HibernationInvoked: v.ns.mediator.resources.Hibernation.Invoked,
+		CurrentPath:        v.present.Path,
+		IsDir:              v.present.IsDirectory(),
+		Depth:              depth,
+		Metrics:            metrics,
 	}
 }
 
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/jaywalk/src/agenor/tapable"
@@ -22,11 +24,16 @@ type readHooks struct {
 type readOptions struct {
 	hooks     readHooks
 	behaviour *pref.SortBehaviour
+	ahead     *readAhead
+	links     *linker
+	timer     *core.LatencyHistogram
+	throttle  *throttle
 }
 
 type agentOptions struct {
 	hooks   *tapable.Hooks
 	defects *pref.DefectOptions
+	timer   *core.LatencyHistogram
 }
 
 // navigatorAgent does work on behalf of the navigator. The agent performs
@@ -47,10 +54,22 @@ func (n *navigatorAgent) Ignite(ignition *enclave.Ignition) {
 func (n *navigatorAgent) top(ctx context.Context,
 	ns *navigationStatic,
 ) (result *enclave.KernelResult, err error) {
-	info, ie := n.ao.hooks.QueryStatus.Invoke()(
-		ns.mediator.resources.Forest.T, ns.tree,
+	info, ie := query(ns.mediator.resources.Forest.T,
+		n.ao.hooks, n.ao.timer, ns.tree,
 	)
 
+	n.ro.throttle.start(ctx)
+	defer n.ro.throttle.stop()
+
+	if n.ro.ahead != nil {
+		n.ro.ahead.start(ctx, ns.mediator.resources.Forest.T)
+		defer n.ro.ahead.stop()
+	}
+
+	if ns.mediator.o.Behaviours.Order == enums.TraversalOrderBreadthFirst {
+		ns.frontier = &frontier{}
+	}
+
 	err = lo.TernaryF(ie != nil,
 		func() error {
 			return n.ao.defects.Fault.Accept(&pref.NavigationFault{
@@ -67,6 +86,17 @@ func (n *navigatorAgent) top(ctx context.Context,
 				},
 			)
 
+			if te == nil && ns.frontier != nil {
+				te = n.drain(ctx, ns)
+			}
+
+			// as with fs.WalkDir, SkipAll denotes a successful early
+			// termination, rather than a failure.
+			//
+			if errors.Is(te, fs.SkipAll) {
+				return nil
+			}
+
 			return te
 		},
 	)
@@ -103,58 +133,90 @@ const (
 // When an error occurs for this node, we return false (skipTraversal) indicating
 // a skip. A skip can mean skip the entire navigation process (fs.SkipAll),
 // or just skip all remaining sibling nodes in this directory (fs.SkipDir).
+// An fs.SkipAll is propagated all the way up to top, so that no further
+// nodes are visited, where it denotes a successful early termination. This
+// is also how the hibernation Retired state ends the traversal once asleep.
 func (n *navigatorAgent) travel(ctx context.Context,
 	ns *navigationStatic,
 	vapour inspection,
 ) (skip bool, err error) {
 	defer func() {
 		if data := recover(); data != nil {
-			// The tree on the mediator always points to the original tree root
-			// requested by the user. The tree on navigation static can be different
-			// when a spawn resume is in play; ie, the Spawn is created using a child
-			// tree and this is what is set on the navigation static when a sub tree
-			// is seeded, but this would be the incorrect tree to persist, rather, we
-			// need the real ancestor that is denoted by the mediator's tree.
-			//
-			to, rescueErr := n.ao.defects.Panic.Rescue(n, &vex{
-				data:     data,
-				anc:      ns.mediator.tree,
-				vap:      vapour,
-				catalyst: "panic",
-				mag:      n.magnitude,
-			}) // wrap???
-
-			// TODO: this needs some serious attention, the underlying panic is not being
-			// made apparent in the error that is being returned.
-			panicErr, ok := data.(error)
-			if !ok {
-				panicErr = errors.New("")
-			}
-
-			if rescueErr != nil {
-				err = locale.NewTraversalNotSavedError(errors.Join(panicErr, rescueErr), to)
-			}
-
-			err = lo.TernaryF(rescueErr != nil,
-				func() error {
-					return locale.NewTraversalNotSavedError(errors.Join(panicErr, rescueErr), to)
-				},
-				func() error {
-					return locale.NewTraversalSavedError(panicErr, to)
-				},
-			)
-
+			err = n.rescue(ns, data, vapour, nil)
 			skip = skipTraversal
 		}
 	}()
 
 	var (
 		parent = vapour.Current()
+		sys    = ns.mediator.resources.Forest.T
 	)
 
+	if n.ro.ahead != nil && ns.mediator.deeper() {
+		// mount points and pruned directories that will not be descended,
+		// are not read ahead
+		//
+		directories := lo.Reject(vapour.Contents().Directories(),
+			func(entry fs.DirEntry, _ int) bool {
+				if ns.mediator.device == nil && ns.mediator.pruner == nil {
+					return false
+				}
+
+				info, e := entry.Info()
+				if e != nil {
+					return false
+				}
+
+				return ns.mediator.foreign(info) || ns.mediator.pruned(
+					core.New(filepath.Join(parent.Path, entry.Name()), entry, info, parent, nil),
+				)
+			},
+		)
+
+		n.ro.ahead.fetch(lo.Map(directories,
+			func(entry fs.DirEntry, _ int) string {
+				return filepath.Join(parent.Path, entry.Name())
+			},
+		))
+
+		// when navigating breadth first, the children are visited after
+		// the parent has been travelled, so their contents must be retained
+		//
+		if ns.frontier == nil {
+			defer n.ro.ahead.discard(parent.Path)
+		}
+	}
+
 	for _, entry := range vapour.Entries() {
 		path := filepath.Join(parent.Path, entry.Name())
 		info, e := entry.Info()
+		node := core.New(path, entry, info, parent, e)
+		node.Link = n.ro.links.target(sys, path, entry)
+
+		// when navigating breadth first, cancellation is detected as the
+		// frontier is drained
+		//
+		if ns.frontier == nil && ctx.Err() != nil {
+			return skipTraversal, n.interrupt(ctx, ns, node,
+				ns.mediator.periscope.Depth()+1, nil,
+			)
+		}
+
+		if link, ok := entry.(*linkEntry); ok && cyclic(parent, link) {
+			// deliver the link itself, without following it
+			//
+			info, e = link.DirEntry.Info()
+			node = core.New(path, link.DirEntry, info, parent, e)
+			node.Link = link.target
+
+			ns.mediator.resources.Binder.Controls.Cycle.Dispatch()(node)
+		}
+
+		if ns.frontier != nil {
+			ns.frontier.push(node, ns.mediator.periscope.Level())
+
+			continue
+		}
 
 		// TODO: check sampling; should happen transparently, by plugin
 
@@ -162,13 +224,7 @@ func (n *navigatorAgent) travel(ctx context.Context,
 		//
 		if progress, err := ns.mediator.impl.Traverse(
 			ctx, ns, servant{
-				node: core.New(
-					path,
-					entry,
-					info,
-					parent,
-					e,
-				),
+				node: node,
 			},
 		); !progress {
 			if err != nil {
@@ -194,7 +250,10 @@ func (n *navigatorAgent) travel(ctx context.Context,
 			case errors.Is(err, fs.SkipDir):
 				continue
 			case errors.Is(err, fs.SkipAll):
-				break
+				// terminate the entire traversal; the SkipAll must be propagated
+				// all the way up to the top.
+				//
+				return skipTraversal, err
 			default:
 				return continueTraversal, err
 			}
@@ -204,6 +263,222 @@ func (n *navigatorAgent) travel(ctx context.Context,
 	return continueTraversal, nil
 }
 
+// Sweep resumes a breadth first navigation from the frontier of nodes
+// that were pending when the previous navigation was interrupted. Since
+// the nodes of the frontier are not related by descent, the ancestors of
+// each are re-acquired from the file system.
+func (n *navigatorAgent) Sweep(ctx context.Context,
+	ns *navigationStatic,
+	paths []string,
+) (*enclave.KernelResult, error) {
+	n.ro.throttle.start(ctx)
+	defer n.ro.throttle.stop()
+
+	if n.ro.ahead != nil {
+		n.ro.ahead.start(ctx, ns.mediator.resources.Forest.T)
+		defer n.ro.ahead.stop()
+	}
+
+	var (
+		sep       = string(filepath.Separator)
+		ancestors = make(map[string]*core.Node)
+	)
+
+	ns.frontier = &frontier{}
+
+	for _, path := range paths {
+		rel, err := filepath.Rel(ns.tree, path)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+sep) {
+			return ns.mediator.impl.Result(ctx), core.NewInvalidPeriscopeRootPathError(
+				ns.tree, path,
+			)
+		}
+
+		ns.frontier.push(n.lineage(ns, path, ancestors),
+			core.TraversalDepth(len(strings.Split(rel, sep))),
+		)
+	}
+
+	err := n.drain(ctx, ns)
+
+	if errors.Is(err, fs.SkipAll) {
+		err = nil
+	}
+
+	return ns.mediator.impl.Result(ctx), err
+}
+
+// lineage creates the node for path, along with its ancestors up to the
+// tree, as they would have been created had they been navigated.
+func (n *navigatorAgent) lineage(ns *navigationStatic,
+	path string,
+	ancestors map[string]*core.Node,
+) *core.Node {
+	if node, found := ancestors[path]; found {
+		return node
+	}
+
+	var (
+		node  *core.Node
+		entry fs.DirEntry
+		sys   = ns.mediator.resources.Forest.T
+	)
+
+	info, err := query(sys, n.ao.hooks, n.ao.timer, path)
+	if info != nil {
+		entry = fs.FileInfoToDirEntry(info)
+	}
+
+	if parent := filepath.Dir(path); path == ns.tree || parent == path {
+		node = core.Top(path, info)
+	} else {
+		node = core.New(path, entry, info, n.lineage(ns, parent, ancestors), err)
+
+		if entry != nil {
+			node.Link = n.ro.links.target(sys, path, entry)
+		}
+	}
+
+	ancestors[path] = node
+
+	return node
+}
+
+// drain visits the pending nodes of the frontier in level order, until
+// either it is exhausted or the navigation is terminated. A SkipDir
+// results in the remaining siblings of the node being discarded, in
+// the same way that they are skipped when navigating depth first.
+func (n *navigatorAgent) drain(ctx context.Context,
+	ns *navigationStatic,
+) error {
+	for {
+		item, ok := ns.frontier.pop()
+		if !ok {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return n.interrupt(ctx, ns, item.node, item.level,
+				append([]string{item.node.Path}, ns.frontier.paths(ns.frontier.size())...),
+			)
+		}
+
+		progress, err := n.visit(ctx, ns, item)
+
+		switch {
+		case err == nil:
+			continue
+
+		case errors.Is(err, fs.SkipDir):
+			if !progress {
+				ns.frontier.drop(item.node.Parent)
+			}
+
+		default:
+			return err
+		}
+	}
+}
+
+// visit traverses a single pending node, at the depth at which it was
+// discovered. If a panic occurs, the frontier saved consists of the node
+// itself followed by the nodes that were pending prior to its visit.
+func (n *navigatorAgent) visit(ctx context.Context,
+	ns *navigationStatic,
+	item *pending,
+) (progress bool, err error) {
+	backlog := ns.frontier.size()
+
+	defer func() {
+		if data := recover(); data != nil {
+			err = n.rescue(ns, data, &navigationVapour{
+				ns:      ns,
+				present: item.node,
+			}, append([]string{item.node.Path}, ns.frontier.paths(backlog)...))
+			progress = skipTraversal
+		}
+	}()
+
+	ns.mediator.periscope.Restore(item.level)
+
+	return ns.mediator.impl.Traverse(ctx, ns, servant{
+		node: item.node,
+	})
+}
+
+// rescue handles a panic that occurs during navigation, by invoking the
+// panic handler, which by default saves the navigation state so that it
+// may be resumed.
+func (n *navigatorAgent) rescue(ns *navigationStatic,
+	data any,
+	vapour inspection,
+	frontier []string,
+) error {
+	// The tree on the mediator always points to the original tree root
+	// requested by the user. The tree on navigation static can be different
+	// when a spawn resume is in play; ie, the Spawn is created using a child
+	// tree and this is what is set on the navigation static when a sub tree
+	// is seeded, but this would be the incorrect tree to persist, rather, we
+	// need the real ancestor that is denoted by the mediator's tree.
+	//
+	to, rescueErr := n.ao.defects.Panic.Rescue(n, &vex{
+		data:     data,
+		anc:      ns.mediator.tree,
+		vap:      vapour,
+		catalyst: "panic",
+		mag:      n.magnitude,
+		pending:  frontier,
+		depth:    ns.mediator.periscope.Depth(),
+	}) // wrap???
+
+	// TODO: this needs some serious attention, the underlying panic is not being
+	// made apparent in the error that is being returned.
+	panicErr, ok := data.(error)
+	if !ok {
+		panicErr = errors.New("")
+	}
+
+	return lo.TernaryF(rescueErr != nil,
+		func() error {
+			return locale.NewTraversalNotSavedError(errors.Join(panicErr, rescueErr), to)
+		},
+		func() error {
+			return locale.NewTraversalSavedError(panicErr, to)
+		},
+	)
+}
+
+// interrupt saves the navigation state when the context has been cancelled,
+// with node, which has not yet been traversed, as the point of resumption.
+// The error returned wraps the cause of the cancellation and carries the
+// path the state was saved to.
+func (n *navigatorAgent) interrupt(ctx context.Context,
+	ns *navigationStatic,
+	node *core.Node,
+	depth core.TraversalDepth,
+	frontier []string,
+) error {
+	cause := ctx.Err()
+	to, err := n.persister.write(&vex{
+		data: cause,
+		anc:  ns.mediator.tree,
+		vap: &navigationVapour{
+			ns:      ns,
+			present: node,
+		},
+		catalyst: "interrupt",
+		mag:      n.magnitude,
+		pending:  frontier,
+		depth:    depth,
+	})
+
+	if err != nil {
+		return locale.NewTraversalNotSavedError(errors.Join(cause, err), to)
+	}
+
+	return locale.NewTraversalSavedError(cause, to)
+}
+
 func (n *navigatorAgent) Save(data pref.RescueData) (string, error) {
 	if v, ok := data.(vexation); ok {
 		return n.persister.write(v)
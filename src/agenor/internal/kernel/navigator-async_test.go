@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -144,6 +145,62 @@ var _ = Describe("Navigator", Ordered, func() {
 		services.Reset()
 	})
 
+	When("sprint with output", func() {
+		It("🧪 should: invoke client for every node on the pool", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				var (
+					wg       sync.WaitGroup
+					invoked  atomic.Uint32
+					received atomic.Uint32
+				)
+
+				result, err := agenor.Sprint(&wg).Configure().Extent(agenor.Prime(
+					&pref.Using{
+						Tree:         lab.Static.RetroWave,
+						Subscription: enums.SubscribeUniversal,
+						Head: pref.Head{
+							Handler: func(_ core.Servant) error {
+								invoked.Add(1)
+								return nil
+							},
+							GetForest: func(_ string) *core.Forest {
+								return &core.Forest{
+									T: fS,
+									R: tfs.New(),
+								}
+							},
+						},
+					},
+					agenor.WithNoW(3),
+					agenor.WithOutput(&pref.OutputOptions{
+						CheckCloseInterval: time.Second / 10,
+						TimeoutOnSend:      time.Second * 3,
+						On: func(outs core.OutputStream) {
+							wg.Add(1)
+
+							go func() {
+								defer wg.Done()
+
+								for output := range outs {
+									Expect(output.Payload.Servant).NotTo(BeNil())
+									received.Add(1)
+								}
+							}()
+						},
+					}),
+				)).Navigate(ctx)
+
+				Expect(err).To(Succeed())
+				Expect(invoked.Load()).To(BeNumerically(">", 0))
+				Expect(invoked.Load()).To(BeEquivalentTo(
+					result.Metrics().Count(enums.MetricNoFilesInvoked) +
+						result.Metrics().Count(enums.MetricNoDirectoriesInvoked),
+				))
+				Expect(received.Load()).To(Equal(invoked.Load()))
+			})
+		}, SpecTimeout(time.Second*2))
+	})
+
 	DescribeTable("sprint",
 		func(specCtx SpecContext, entry *lab.AsyncOkTE) {
 			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
@@ -0,0 +1,184 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("BreadthFirst", Ordered, func() {
+	var (
+		fS      *luna.MemFS
+		college string
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		college = filepath.Join(lab.Static.RetroWave, "College")
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	// levelOf returns the number of segments of path below the tree
+	levelOf := func(path string) int {
+		if path == lab.Static.RetroWave {
+			return 0
+		}
+
+		return len(strings.Split(path, string(filepath.Separator))) - 1
+	}
+
+	walk := func(ctx context.Context,
+		subscription enums.Subscription,
+		handler func(node *core.Node) error,
+		settings ...pref.Option,
+	) []*core.Node {
+		visited := []*core.Node{}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: subscription,
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						node := servant.Node()
+						visited = append(visited, node)
+
+						if handler != nil {
+							return handler(node)
+						}
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	paths := func(nodes []*core.Node) []string {
+		result := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			result = append(result, node.Path)
+		}
+
+		return result
+	}
+
+	When("universal", func() {
+		It("🧪 should: visit in level order with correct depth and scope", func(ctx SpecContext) {
+			depthFirst := walk(ctx, enums.SubscribeUniversal, nil)
+			breadthFirst := walk(ctx, enums.SubscribeUniversal, nil,
+				agenor.WithBreadthFirst(),
+			)
+
+			Expect(paths(breadthFirst)).To(ConsistOf(paths(depthFirst)))
+			Expect(paths(breadthFirst)).NotTo(Equal(paths(depthFirst)))
+
+			level := 0
+			for _, node := range breadthFirst {
+				Expect(levelOf(node.Path)).To(BeNumerically(">=", level),
+					"node: '%v' visited out of level order", node.Path,
+				)
+				level = levelOf(node.Path)
+
+				Expect(int(node.VisualDepth())).To(Equal(level),
+					"node: '%v' has incorrect depth", node.Path,
+				)
+			}
+
+			for _, node := range breadthFirst {
+				if node.Path == college {
+					Expect(node.Extension.Scope & enums.ScopeTop).To(
+						Equal(enums.ScopeTop),
+					)
+				}
+			}
+		})
+	})
+
+	When("directories with depth limit", func() {
+		It("🧪 should: visit the same directories as depth first", func(ctx SpecContext) {
+			depthFirst := walk(ctx, enums.SubscribeDirectories, nil,
+				agenor.WithDepth(2),
+			)
+			breadthFirst := walk(ctx, enums.SubscribeDirectories, nil,
+				agenor.WithDepth(2),
+				agenor.WithBreadthFirst(),
+			)
+
+			Expect(paths(breadthFirst)).To(ConsistOf(paths(depthFirst)))
+		})
+	})
+
+	When("client returns SkipDir for a directory", func() {
+		It("🧪 should: not visit its contents", func(ctx SpecContext) {
+			visited := walk(ctx, enums.SubscribeUniversal, func(node *core.Node) error {
+				if node.Path == college {
+					return fs.SkipDir
+				}
+
+				return nil
+			}, agenor.WithBreadthFirst())
+
+			Expect(paths(visited)).To(ContainElement(college))
+			Expect(paths(visited)).NotTo(ContainElement(
+				HavePrefix(college + string(filepath.Separator)),
+			))
+		})
+	})
+
+	When("client returns SkipAll", func() {
+		It("🧪 should: visit no further nodes", func(ctx SpecContext) {
+			visited := walk(ctx, enums.SubscribeUniversal, func(node *core.Node) error {
+				if levelOf(node.Path) == 2 {
+					return fs.SkipAll
+				}
+
+				return nil
+			}, agenor.WithBreadthFirst())
+
+			last := visited[len(visited)-1]
+
+			Expect(levelOf(last.Path)).To(Equal(2))
+			Expect(paths(visited[:len(visited)-1])).To(HaveEach(
+				WithTransform(levelOf, BeNumerically("<", 2)),
+			))
+		})
+	})
+})
@@ -23,6 +23,7 @@ func (n *navigatorDirectories) Traverse(ctx context.Context,
 	servant core.Servant,
 ) (bool, error) {
 	current := servant.Node()
+	mounted := ns.mediator.mounted(current)
 	descended := ns.mediator.descend(current)
 
 	defer func(permit bool) {
@@ -33,12 +34,16 @@ func (n *navigatorDirectories) Traverse(ctx context.Context,
 		return continueTraversal, nil
 	}
 
-	vapour, err := n.inspect(ns, servant)
+	vapour, err := n.inspect(ns, servant, mounted)
 
 	if e := ns.mediator.Invoke(servant, vapour); e != nil {
 		return continueTraversal, e
 	}
 
+	if mounted {
+		return continueTraversal, nil
+	}
+
 	if skip, e := ns.mediator.o.Defects.Skip.Ask(
 		current, vapour.Contents(), err,
 	); skip == enums.SkipAllTraversal {
@@ -50,6 +55,7 @@ func (n *navigatorDirectories) Traverse(ctx context.Context,
 
 func (n *navigatorDirectories) inspect(ns *navigationStatic,
 	servant core.Servant,
+	mounted bool,
 ) (inspection, error) {
 	var (
 		current = servant.Node()
@@ -65,13 +71,17 @@ func (n *navigatorDirectories) inspect(ns *navigationStatic,
 	// interested in directories and therefore forced to use
 	// NavigationBehaviours.SortBehaviour.SortFilesFirst=true instead.
 	//
-	vapour.cargo, err = read(ns.mediator.resources.Forest.T,
-		n.ro,
-		current.Path,
-	)
-
-	vapour.Sort(enums.EntryTypeDirectory)
-	vapour.Pick(enums.EntryTypeDirectory)
+	if mounted {
+		vapour.cargo = newEmptyContents()
+	} else {
+		vapour.cargo, err = read(ns.mediator.resources.Forest.T,
+			n.ro,
+			current.Path,
+		)
+
+		vapour.Sort(enums.EntryTypeDirectory)
+		vapour.Pick(enums.EntryTypeDirectory)
+	}
 
 	extend(ns, vapour)
 
@@ -24,6 +24,8 @@ func PrimeArtefacts(inception *Inception,
 
 func newImpl(o *pref.Options,
 	inception *Inception,
+	timers *instruments,
+	limiter *throttle,
 ) (impl NavigatorImpl, err error) {
 	subscription := inception.Subscription
 
@@ -31,6 +33,7 @@ func newImpl(o *pref.Options,
 		ao: &agentOptions{
 			hooks:   &o.Hooks,
 			defects: &o.Defects,
+			timer:   timers.status,
 		},
 		ro: &readOptions{
 			hooks: readHooks{
@@ -38,6 +41,12 @@ func newImpl(o *pref.Options,
 				sort: o.Hooks.Sort,
 			},
 			behaviour: &o.Behaviours.Sort,
+			ahead: newReadAhead(o.Concurrency.ReadAhead, o.Hooks.ReadDirectory,
+				timers.read, limiter,
+			),
+			links:    &linker{mode: o.Behaviours.Links.Mode},
+			timer:    timers.read,
+			throttle: limiter,
 		},
 		resources: inception.Resources,
 		persister: author{
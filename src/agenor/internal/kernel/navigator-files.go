@@ -24,6 +24,7 @@ func (n *navigatorFiles) Traverse(ctx context.Context,
 ) (bool, error) {
 	current := servant.Node()
 	isDir := current.IsDirectory()
+	mounted := isDir && ns.mediator.mounted(current)
 	descended := isDir && ns.mediator.descend(current)
 
 	defer func(permit bool) {
@@ -32,7 +33,7 @@ func (n *navigatorFiles) Traverse(ctx context.Context,
 		}
 	}(descended)
 
-	if isDir && !descended {
+	if isDir && (!descended || mounted) {
 		return continueTraversal, nil
 	}
 
@@ -50,3 +50,7 @@ func (n *navigatorHades) Snooze(context.Context,
 // Bye is a no-op.
 func (n *navigatorHades) Bye(_ core.TraverseResult) {
 }
+
+// Conclude is a no-op.
+func (n *navigatorHades) Conclude(_ error) {
+}
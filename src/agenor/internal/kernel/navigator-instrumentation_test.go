@@ -0,0 +1,154 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Instrumentation", Ordered, func() {
+	const (
+		delay = time.Millisecond
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	navigate := func(ctx context.Context, factory agenor.NavigatorFactory,
+		settings ...pref.Option,
+	) core.TraverseResult {
+		result, err := factory.Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeDirectories,
+				Head: pref.Head{
+					Handler: func(_ core.Servant) error {
+						time.Sleep(delay)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return result
+	}
+
+	walk := func(ctx context.Context, settings ...pref.Option) core.TraverseResult {
+		return navigate(ctx, agenor.Walk(), settings...)
+	}
+
+	When("enabled", func() {
+		It("🧪 should: record durations of reads, status queries and callbacks", func(ctx SpecContext) {
+			result := walk(ctx, agenor.WithInstrumentation())
+			invoked := result.Metrics().Count(enums.MetricNoDirectoriesInvoked)
+			client := result.Timings().Latency(enums.InstrumentClient)
+
+			Expect(client.Count()).To(BeEquivalentTo(invoked))
+			Expect(client.Total()).To(BeNumerically(">=", time.Duration(invoked)*delay))
+			Expect(client.Percentile(50)).To(BeNumerically(">=", delay))
+			Expect(result.Timings().Latency(enums.InstrumentReadDirectory).Count()).To(
+				BeEquivalentTo(invoked),
+			)
+			Expect(result.Timings().Latency(enums.InstrumentQueryStatus).Count()).To(
+				BeEquivalentTo(1),
+			)
+		})
+	})
+
+	When("enabled with sprint", func() {
+		It("🧪 should: record durations of callbacks invoked by the pool", func(ctx SpecContext) {
+			var wg sync.WaitGroup
+
+			result := navigate(ctx, agenor.Sprint(&wg),
+				agenor.WithInstrumentation(), agenor.WithNoW(3),
+			)
+			wg.Wait()
+
+			invoked := result.Metrics().Count(enums.MetricNoDirectoriesInvoked)
+			client := result.Timings().Latency(enums.InstrumentClient)
+
+			Expect(client.Count()).To(BeEquivalentTo(invoked))
+			Expect(client.Total()).To(BeNumerically(">=", time.Duration(invoked)*delay))
+			Expect(client.Percentile(50)).To(BeNumerically(">=", delay))
+		})
+	})
+
+	When("enabled with status hook", func() {
+		It("🧪 should: record duration of every status query", func(ctx SpecContext) {
+			queried := 0
+			result := walk(ctx, agenor.WithInstrumentation(),
+				agenor.WithHookQueryStatus(func(qsys fs.StatFS, path string) (fs.FileInfo, error) {
+					queried++
+
+					return qsys.Stat(path)
+				}),
+			)
+
+			Expect(queried).To(BeNumerically(">", 0))
+			Expect(result.Timings().Latency(enums.InstrumentQueryStatus).Count()).To(
+				BeEquivalentTo(queried),
+			)
+		})
+	})
+
+	When("enabled with read ahead", func() {
+		It("🧪 should: record durations of reads performed ahead", func(ctx SpecContext) {
+			result := walk(ctx, agenor.WithInstrumentation(), agenor.WithReadAhead(2))
+
+			Expect(result.Timings().Latency(enums.InstrumentReadDirectory).Count()).To(
+				BeEquivalentTo(result.Metrics().Count(enums.MetricNoDirectoriesInvoked)),
+			)
+		})
+	})
+
+	When("disabled", func() {
+		It("🧪 should: not record any durations", func(ctx SpecContext) {
+			result := walk(ctx)
+
+			Expect(result.Timings().Latency(enums.InstrumentClient).Count()).To(BeZero())
+			Expect(result.Timings().Latency(enums.InstrumentReadDirectory).Count()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("SizeMetrics", Ordered, func() {
+	const (
+		tree = "sizes"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		file := func(size int) *fstest.MapFile {
+			return &fstest.MapFile{
+				Data: []byte(strings.Repeat("x", size)),
+				Mode: lab.Perms.File,
+			}
+		}
+		directory := &fstest.MapFile{
+			Mode: fs.ModeDir | lab.Perms.Dir,
+		}
+
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"sizes":                 directory,
+				"sizes/a.txt":           file(10),
+				"sizes/b.log":           file(300),
+				"sizes/one":             directory,
+				"sizes/one/c.txt":       file(20),
+				"sizes/one/two":         directory,
+				"sizes/one/two/d.txt":   file(40),
+				"sizes/one/two/e.log":   file(5),
+				"sizes/one/two/three":   directory,
+				"sizes/one/two/three/x": directory,
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	walk := func(ctx context.Context, settings ...pref.Option) core.TraverseResult {
+		result, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         tree,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler: func(_ core.Servant) error {
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return result
+	}
+
+	When("navigating", func() {
+		It("🧪 should: report bytes, largest file and deepest depth", func(ctx SpecContext) {
+			metrics := walk(ctx).Metrics()
+
+			Expect(metrics.Count(enums.MetricNoFileBytesInvoked)).To(BeEquivalentTo(375))
+			Expect(metrics.Count(enums.MetricLargestFileSize)).To(BeEquivalentTo(300))
+			Expect(metrics.Count(enums.MetricDeepestDepth)).To(BeEquivalentTo(4))
+		})
+	})
+
+	When("filtered", func() {
+		It("🧪 should: report bytes filtered out", func(ctx SpecContext) {
+			metrics := walk(ctx, agenor.WithFilter(&pref.FilterOptions{
+				Node: &core.FilterDef{
+					Type:        enums.FilterTypeGlob,
+					Description: "text files",
+					Pattern:     "*.txt",
+					Scope:       enums.ScopeFile,
+				},
+			})).Metrics()
+
+			Expect(metrics.Count(enums.MetricNoFileBytesInvoked)).To(BeEquivalentTo(70))
+			Expect(metrics.Count(enums.MetricNoFileBytesFilteredOut)).To(BeEquivalentTo(305))
+			Expect(metrics.Count(enums.MetricLargestFileSize)).To(BeEquivalentTo(40))
+		})
+	})
+
+	When("depth limited", func() {
+		It("🧪 should: report the deepest depth descended", func(ctx SpecContext) {
+			metrics := walk(ctx, agenor.WithDepth(2)).Metrics()
+
+			Expect(metrics.Count(enums.MetricDeepestDepth)).To(BeEquivalentTo(2))
+		})
+	})
+})
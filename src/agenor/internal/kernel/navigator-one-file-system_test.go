@@ -0,0 +1,161 @@
+//go:build !windows
+
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+// deviceInfo simulates the native status of a local file system entry,
+// so that the device of an entry in a memory file system can be defined.
+type deviceInfo struct {
+	fs.FileInfo
+	status *syscall.Stat_t
+}
+
+func (i *deviceInfo) Sys() any {
+	return i.status
+}
+
+type deviceEntry struct {
+	fs.DirEntry
+	info fs.FileInfo
+}
+
+func (e *deviceEntry) Info() (fs.FileInfo, error) {
+	return e.info, nil
+}
+
+var _ = Describe("OneFileSystem", Ordered, func() {
+	var (
+		fS    *luna.MemFS
+		mount string
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		mount = filepath.Join(lab.Static.RetroWave, "College")
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	device := func(path string, info fs.FileInfo) fs.FileInfo {
+		status := &syscall.Stat_t{Dev: 1}
+
+		if path == mount || strings.HasPrefix(path, mount+string(filepath.Separator)) {
+			status.Dev = 2
+		}
+
+		return &deviceInfo{
+			FileInfo: info,
+			status:   status,
+		}
+	}
+
+	walk := func(ctx context.Context, settings ...pref.Option) ([]string, core.TraverseResult) {
+		visited := []string{}
+
+		result, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						visited = append(visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			append(settings,
+				agenor.WithHookQueryStatus(func(qsys fs.StatFS, path string) (fs.FileInfo, error) {
+					info, err := qsys.Stat(path)
+					if err != nil {
+						return nil, err
+					}
+
+					return device(path, info), nil
+				}),
+				agenor.WithHookReadDirectory(func(rsys fs.ReadDirFS, dirname string) ([]fs.DirEntry, error) {
+					entries, err := pref.DefaultReadEntriesHook(rsys, dirname)
+
+					for i, entry := range entries {
+						info, _ := entry.Info()
+						entries[i] = &deviceEntry{
+							DirEntry: entry,
+							info:     device(filepath.Join(dirname, entry.Name()), info),
+						}
+					}
+
+					return entries, err
+				}),
+			)...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return visited, result
+	}
+
+	When("confined to one file system", func() {
+		It("🧪 should: deliver but not descend into mount point", func(ctx SpecContext) {
+			visited, result := walk(ctx, agenor.WithOneFileSystem())
+
+			Expect(visited).To(ContainElement(
+				filepath.Join(lab.Static.RetroWave, "Chromatics", "Night Drive"),
+			))
+			Expect(visited).To(ContainElement(mount))
+			Expect(visited).NotTo(ContainElement(HavePrefix(mount + string(filepath.Separator))))
+			Expect(result.Metrics().Count(enums.MetricNoMountPointsSkipped)).To(
+				BeEquivalentTo(1),
+			)
+		})
+	})
+
+	When("not confined to one file system", func() {
+		It("🧪 should: descend into mount point", func(ctx SpecContext) {
+			visited, result := walk(ctx)
+
+			Expect(visited).To(ContainElement(
+				filepath.Join(mount, "Teenage Color"),
+			))
+			Expect(result.Metrics().Count(enums.MetricNoMountPointsSkipped)).To(
+				BeEquivalentTo(0),
+			)
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Prune", Ordered, func() {
+	var (
+		fS      *luna.MemFS
+		college string
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+		college = filepath.Join(lab.Static.RetroWave, "College")
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	type outcome struct {
+		visited []string
+		read    []string
+		result  core.TraverseResult
+	}
+
+	walk := func(ctx context.Context, prune *core.FilterDef, settings ...pref.Option) *outcome {
+		var (
+			mx      sync.Mutex
+			outcome = &outcome{}
+		)
+
+		result, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						outcome.visited = append(outcome.visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			append(settings,
+				agenor.WithFilter(&pref.FilterOptions{
+					Prune: prune,
+				}),
+				agenor.WithHookReadDirectory(func(rsys fs.ReadDirFS, dirname string) ([]fs.DirEntry, error) {
+					mx.Lock()
+					outcome.read = append(outcome.read, dirname)
+					mx.Unlock()
+
+					return pref.DefaultReadEntriesHook(rsys, dirname)
+				}),
+			)...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		outcome.result = result
+
+		return outcome
+	}
+
+	When("top level directory matches", func() {
+		It("🧪 should: neither read, invoke nor descend", func(ctx SpecContext) {
+			outcome := walk(ctx, &core.FilterDef{
+				Type:    enums.FilterTypeGlob,
+				Pattern: "College",
+			})
+
+			Expect(outcome.visited).To(ContainElement(
+				filepath.Join(lab.Static.RetroWave, "Chromatics", "Night Drive"),
+			))
+			Expect(outcome.visited).NotTo(ContainElement(HavePrefix(college)))
+			Expect(outcome.read).NotTo(ContainElement(HavePrefix(college)))
+			Expect(outcome.result.Metrics().Count(enums.MetricNoDirectoriesPruned)).To(
+				BeEquivalentTo(1),
+			)
+		})
+	})
+
+	When("intermediate directories match", func() {
+		It("🧪 should: prune each of them", func(ctx SpecContext) {
+			outcome := walk(ctx, &core.FilterDef{
+				Type:    enums.FilterTypeRegex,
+				Pattern: "^(Northern|Teenage)",
+			})
+
+			Expect(outcome.visited).To(ContainElement(college))
+			Expect(outcome.visited).NotTo(ContainElement(
+				filepath.Join(college, "Teenage Color"),
+			))
+			Expect(outcome.result.Metrics().Count(enums.MetricNoDirectoriesPruned)).To(
+				BeEquivalentTo(2),
+			)
+		})
+	})
+
+	When("reading ahead", func() {
+		It("🧪 should: not read pruned directories", func(ctx SpecContext) {
+			outcome := walk(ctx, &core.FilterDef{
+				Type:    enums.FilterTypeGlob,
+				Pattern: "College",
+			}, agenor.WithReadAhead(2))
+
+			Expect(outcome.read).NotTo(ContainElement(HavePrefix(college)))
+			Expect(outcome.visited).NotTo(ContainElement(HavePrefix(college)))
+		})
+	})
+
+	When("directory does not match", func() {
+		It("🧪 should: not modify the node being descended", func(ctx SpecContext) {
+			extensions := map[string]core.Extension{}
+
+			walk(ctx, &core.FilterDef{
+				Type:    enums.FilterTypeGlob,
+				Pattern: "College",
+			}, agenor.WithOnDescend(func(node *core.Node) {
+				extensions[node.Path] = node.Extension
+			}))
+
+			Expect(extensions).To(HaveKey(filepath.Join(lab.Static.RetroWave, "Chromatics")))
+			for path, extension := range extensions {
+				Expect(extension).To(BeZero(), "extension of '%v' should be untouched", path)
+			}
+		})
+	})
+
+	When("file scoped", func() {
+		It("🧪 should: not prune any directories", func(ctx SpecContext) {
+			outcome := walk(ctx, &core.FilterDef{
+				Type:    enums.FilterTypeGlob,
+				Pattern: "*",
+				Scope:   enums.ScopeFile,
+			})
+
+			Expect(outcome.visited).To(ContainElement(college))
+			Expect(outcome.result.Metrics().Count(enums.MetricNoDirectoriesPruned)).To(
+				BeEquivalentTo(0),
+			)
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package kernel_test
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"testing/fstest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type throttleTE struct {
+	lab.DescribedTE
+	throttle pref.ThrottleOptions
+	settings []pref.Option
+	metric   enums.Metric
+	inactive []enums.Metric
+}
+
+var _ = Describe("Throttle", Ordered, func() {
+	const (
+		tree = "throttle"
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+
+		file := func(size int) *fstest.MapFile {
+			return &fstest.MapFile{
+				Data: []byte(strings.Repeat("x", size)),
+				Mode: lab.Perms.File,
+			}
+		}
+		directory := &fstest.MapFile{
+			Mode: fs.ModeDir | lab.Perms.Dir,
+		}
+
+		// 5 directories and 5 files, totalling 375 bytes
+		//
+		fS = &luna.MemFS{
+			MapFS: fstest.MapFS{
+				"throttle":                 directory,
+				"throttle/a.txt":           file(10),
+				"throttle/b.log":           file(300),
+				"throttle/one":             directory,
+				"throttle/one/c.txt":       file(20),
+				"throttle/one/two":         directory,
+				"throttle/one/two/d.txt":   file(40),
+				"throttle/one/two/e.log":   file(5),
+				"throttle/one/two/three":   directory,
+				"throttle/one/two/three/x": directory,
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	walk := func(ctx context.Context, settings ...pref.Option) (core.TraverseResult, time.Duration) {
+		started := time.Now()
+		result, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         tree,
+				Subscription: enums.SubscribeUniversal,
+				Head: pref.Head{
+					Handler: func(_ core.Servant) error {
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		Expect(err).To(Succeed())
+
+		return result, time.Since(started)
+	}
+
+	// Since the bucket starts with a second's worth of tokens, the
+	// navigation is only held up by the remainder, so for each of the
+	// following, the throttled navigation should take about 1.5s.
+	//
+	const minimum = time.Second * 14 / 10
+
+	DescribeTable("capped",
+		func(ctx SpecContext, entry *throttleTE) {
+			result, elapsed := walk(ctx,
+				append(entry.settings, agenor.WithThrottle(&entry.throttle))...,
+			)
+			metrics := result.Metrics()
+
+			Expect(elapsed).To(BeNumerically(">=", minimum))
+			Expect(metrics.Count(entry.metric)).To(BeNumerically(">", 0))
+			Expect(metrics.Count(enums.MetricNoFilesInvoked)).To(BeEquivalentTo(5))
+			Expect(metrics.Count(enums.MetricNoDirectoriesInvoked)).To(BeEquivalentTo(5))
+
+			for _, mt := range entry.inactive {
+				Expect(metrics.Count(mt)).To(BeEquivalentTo(0),
+					"metric: '%v' should not be reported", mt,
+				)
+			}
+		},
+		func(entry *throttleTE) string {
+			return lab.FormatTestDescription(&entry.DescribedTE)
+		},
+
+		Entry(nil, &throttleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "nodes",
+				Should: "limit the rate at which the client is invoked",
+			},
+			throttle: pref.ThrottleOptions{Nodes: 4},
+			metric:   enums.MetricNodeRate,
+			inactive: []enums.Metric{enums.MetricReadRate, enums.MetricByteRate},
+		}),
+
+		Entry(nil, &throttleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "reads",
+				Should: "limit the rate at which directories are read",
+			},
+			throttle: pref.ThrottleOptions{Reads: 2},
+			metric:   enums.MetricReadRate,
+			inactive: []enums.Metric{enums.MetricNodeRate, enums.MetricByteRate},
+		}),
+
+		Entry(nil, &throttleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "reads with read ahead",
+				Should: "limit the rate at which directories are prefetched",
+			},
+			throttle: pref.ThrottleOptions{Reads: 2},
+			metric:   enums.MetricReadRate,
+			inactive: []enums.Metric{enums.MetricNodeRate, enums.MetricByteRate},
+			settings: []pref.Option{agenor.WithReadAhead(2)},
+		}),
+
+		Entry(nil, &throttleTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "bytes",
+				Should: "limit the rate of client I/O",
+			},
+			throttle: pref.ThrottleOptions{Bytes: 150},
+			metric:   enums.MetricByteRate,
+			inactive: []enums.Metric{enums.MetricNodeRate, enums.MetricReadRate},
+		}),
+	)
+
+	When("not throttled", func() {
+		It("🧪 should: not report any rates", func(ctx SpecContext) {
+			result, _ := walk(ctx)
+			metrics := result.Metrics()
+
+			Expect(metrics.Count(enums.MetricNodeRate)).To(BeEquivalentTo(0))
+			Expect(metrics.Count(enums.MetricReadRate)).To(BeEquivalentTo(0))
+			Expect(metrics.Count(enums.MetricByteRate)).To(BeEquivalentTo(0))
+		})
+	})
+})
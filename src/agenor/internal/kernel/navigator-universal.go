@@ -24,6 +24,7 @@ func (n *navigatorUniversal) Traverse(ctx context.Context,
 ) (bool, error) {
 	current := servant.Node()
 	isDir := current.IsDirectory()
+	mounted := isDir && ns.mediator.mounted(current)
 	descended := isDir && ns.mediator.descend(current)
 
 	defer func(permit bool) {
@@ -36,13 +37,13 @@ func (n *navigatorUniversal) Traverse(ctx context.Context,
 		return continueTraversal, nil
 	}
 
-	vapour, err := n.inspect(ns, servant)
+	vapour, err := n.inspect(ns, servant, mounted)
 
 	if e := ns.mediator.Invoke(servant, vapour); e != nil {
 		return continueTraversal, e
 	}
 
-	if !isDir {
+	if !isDir || mounted {
 		return continueTraversal, nil
 	}
 
@@ -59,6 +60,7 @@ func (n *navigatorUniversal) Traverse(ctx context.Context,
 
 func (n *navigatorUniversal) inspect(ns *navigationStatic,
 	servant core.Servant,
+	mounted bool,
 ) (inspection, error) {
 	var (
 		current = servant.Node()
@@ -69,7 +71,11 @@ func (n *navigatorUniversal) inspect(ns *navigationStatic,
 		err error
 	)
 
-	if current.IsDirectory() {
+	switch {
+	case mounted:
+		vapour.cargo = newEmptyContents()
+
+	case current.IsDirectory():
 		vapour.cargo, err = read(ns.mediator.resources.Forest.T,
 			n.ro,
 			current.Path,
@@ -77,7 +83,8 @@ func (n *navigatorUniversal) inspect(ns *navigationStatic,
 
 		vapour.Sort(enums.EntryTypeAll)
 		vapour.Pick(enums.EntryTypeAll)
-	} else {
+
+	default:
 		vapour.clear()
 	}
 
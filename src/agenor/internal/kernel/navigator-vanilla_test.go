@@ -1,6 +1,7 @@
 package kernel_test
 
 import (
+	"io/fs"
 	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -276,4 +277,42 @@ var _ = Describe("NavigatorUniversal", Ordered, func() {
 			},
 		}),
 	)
+	When("client returns SkipAll", func() {
+		It("🧪 should: end traversal successfully, visiting no further nodes", func(ctx SpecContext) {
+			var (
+				college   = filepath.Join(lab.Static.RetroWave, "College")
+				visited   []string
+				descended []string
+			)
+
+			_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+				&pref.Using{
+					Tree:         lab.Static.RetroWave,
+					Subscription: enums.SubscribeUniversal,
+					Head: pref.Head{
+						Handler: func(servant core.Servant) error {
+							path := servant.Node().Path
+							visited = append(visited, path)
+
+							return lo.Ternary(path == college, fs.SkipAll, nil)
+						},
+						GetForest: func(_ string) *core.Forest {
+							return &core.Forest{
+								T: fS,
+								R: tfs.New(),
+							}
+						},
+					},
+				},
+				agenor.WithOnDescend(func(node *core.Node) {
+					descended = append(descended, node.Path)
+				}),
+			)).Navigate(ctx)
+
+			Expect(err).To(Succeed())
+			Expect(visited).NotTo(BeEmpty())
+			Expect(visited[len(visited)-1]).To(Equal(college))
+			Expect(descended[len(descended)-1]).To(Equal(college))
+		})
+	})
 })
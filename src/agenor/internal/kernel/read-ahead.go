@@ -0,0 +1,160 @@
+package kernel
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sync"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/tapable"
+)
+
+type (
+	// prefetch is the result of reading a directory ahead of it being
+	// navigated. done is closed once entries and err have been populated.
+	prefetch struct {
+		path    string
+		entries []fs.DirEntry
+		err     error
+		done    chan struct{}
+	}
+
+	// readAhead reads the contents of sub-directories on a bounded set of
+	// go-routines, while the navigator is still busy with the parent
+	// directory. The navigator continues to visit directories in exactly
+	// the same order; read ahead only changes where the contents come from
+	// (see take), so delivery order is unaffected.
+	readAhead struct {
+		ctx      context.Context
+		sys      fs.ReadDirFS
+		read     tapable.Hook[core.ReadDirectoryHook, core.ChainReadDirectoryHook]
+		jobs     chan *prefetch
+		workers  sync.WaitGroup
+		mux      sync.Mutex
+		pending  map[string]*prefetch
+		now      uint
+		timer    *core.LatencyHistogram
+		throttle *throttle
+	}
+)
+
+func newReadAhead(now uint,
+	read tapable.Hook[core.ReadDirectoryHook, core.ChainReadDirectoryHook],
+	timer *core.LatencyHistogram,
+	limiter *throttle,
+) *readAhead {
+	if now == 0 {
+		return nil
+	}
+
+	return &readAhead{
+		read:     read,
+		now:      now,
+		timer:    timer,
+		throttle: limiter,
+	}
+}
+
+// start spawns the workers for the duration of a single top level
+// navigation. Since a resume may invoke multiple top level navigations
+// in succession, start must always be paired with stop.
+func (r *readAhead) start(ctx context.Context, sys fs.ReadDirFS) {
+	r.ctx = ctx
+	r.sys = sys
+	r.jobs = make(chan *prefetch, r.now)
+	r.pending = make(map[string]*prefetch)
+
+	for range r.now {
+		r.workers.Add(1)
+
+		go r.work()
+	}
+}
+
+func (r *readAhead) work() {
+	defer r.workers.Done()
+
+	for job := range r.jobs {
+		if err := r.ctx.Err(); err != nil {
+			job.err = err
+		} else if err := r.throttle.read(); err != nil {
+			job.err = err
+		} else {
+			stop := clock(r.timer)
+			job.entries, job.err = r.read.Invoke()(r.sys, job.path)
+			stop()
+		}
+
+		close(job.done)
+	}
+}
+
+// fetch requests that the directories specified are read ahead. Paths are
+// submitted in order but without blocking; if all workers are busy, the
+// remaining directories are simply read when they are navigated.
+func (r *readAhead) fetch(paths []string) {
+	for _, path := range paths {
+		r.mux.Lock()
+		if _, found := r.pending[path]; found {
+			r.mux.Unlock()
+
+			continue
+		}
+
+		job := &prefetch{
+			path: path,
+			done: make(chan struct{}),
+		}
+		r.pending[path] = job
+		r.mux.Unlock()
+
+		select {
+		case r.jobs <- job:
+		default:
+			r.mux.Lock()
+			delete(r.pending, path)
+			r.mux.Unlock()
+
+			return
+		}
+	}
+}
+
+// take retrieves the prefetched contents of the directory at path, waiting
+// for the read to complete if it is still in progress. nil is returned if
+// path was not read ahead.
+func (r *readAhead) take(path string) *prefetch {
+	r.mux.Lock()
+	job, found := r.pending[path]
+	delete(r.pending, path)
+	r.mux.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	<-job.done
+
+	return job
+}
+
+// discard drops all prefetched results for the children of parent that
+// have not been taken; eg those skipped by a filter or a SkipDir.
+func (r *readAhead) discard(parent string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	for path := range r.pending {
+		if filepath.Dir(path) == parent {
+			delete(r.pending, path)
+		}
+	}
+}
+
+// stop terminates the workers once the top level navigation is complete.
+func (r *readAhead) stop() {
+	close(r.jobs)
+	r.workers.Wait()
+	r.pending = nil
+}
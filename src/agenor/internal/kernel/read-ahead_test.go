@@ -0,0 +1,214 @@
+package kernel_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("ReadAhead", Ordered, func() {
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(verbose, lab.Static.RetroWave)
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	navigate := func(ctx context.Context,
+		subscription enums.Subscription,
+		settings ...pref.Option,
+	) ([]string, error) {
+		visited := []string{}
+
+		_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+			&pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: subscription,
+				Head: pref.Head{
+					Handler: func(servant core.Servant) error {
+						visited = append(visited, servant.Node().Path)
+
+						return nil
+					},
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			},
+			settings...,
+		)).Navigate(ctx)
+
+		return visited, err
+	}
+
+	walk := func(ctx context.Context,
+		subscription enums.Subscription,
+		settings ...pref.Option,
+	) []string {
+		visited, err := navigate(ctx, subscription, settings...)
+		Expect(err).To(Succeed())
+
+		return visited
+	}
+
+	DescribeTable("delivery order",
+		func(ctx SpecContext, subscription enums.Subscription, settings ...pref.Option) {
+			expected := walk(ctx, subscription, settings...)
+			actual := walk(ctx, subscription,
+				append(settings, agenor.WithReadAhead(3))...,
+			)
+
+			Expect(expected).NotTo(BeEmpty())
+			Expect(actual).To(Equal(expected))
+		},
+		func(subscription enums.Subscription, settings ...pref.Option) string {
+			return fmt.Sprintf("🧪 ===> given: subscription '%v' (%v options), "+
+				"should: deliver in same order as sequential read",
+				subscription, len(settings),
+			)
+		},
+
+		Entry(nil, enums.SubscribeUniversal),
+		Entry(nil, enums.SubscribeFiles),
+		Entry(nil, enums.SubscribeDirectories),
+		Entry(nil, enums.SubscribeUniversal, agenor.WithDepth(2)),
+		Entry(nil, enums.SubscribeUniversal, agenor.WithHookCaseSensitiveSort()),
+	)
+
+	When("navigator is held up in a sub-directory", func() {
+		It("🧪 should: read sibling before it is descended", func(ctx SpecContext) {
+			var (
+				mx    sync.Mutex
+				read  = map[string]bool{}
+				first string
+				ahead bool
+			)
+
+			siblingRead := func(path string) bool {
+				mx.Lock()
+				defer mx.Unlock()
+
+				for p := range read {
+					if filepath.Dir(p) == lab.Static.RetroWave && p != path {
+						return true
+					}
+				}
+
+				return false
+			}
+
+			walk(ctx, enums.SubscribeUniversal,
+				agenor.WithReadAhead(3),
+				agenor.WithHookReadDirectory(func(rsys fs.ReadDirFS, dirname string) ([]fs.DirEntry, error) {
+					mx.Lock()
+					read[dirname] = true
+					mx.Unlock()
+
+					return pref.DefaultReadEntriesHook(rsys, dirname)
+				}),
+				agenor.WithOnDescend(func(node *core.Node) {
+					if first != "" || node.Parent == nil || node.Parent.Path != lab.Static.RetroWave {
+						return
+					}
+
+					// whilst the navigator is held up here, the siblings of the
+					// first sub-directory can only be read by read ahead
+					//
+					first = node.Path
+					for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+						if ahead = siblingRead(node.Path); ahead {
+							break
+						}
+
+						time.Sleep(time.Millisecond)
+					}
+				}),
+			)
+
+			Expect(first).NotTo(BeEmpty())
+			Expect(ahead).To(BeTrue(), "sibling of '%v' should have been read ahead", first)
+		})
+	})
+
+	When("read ahead fails", func() {
+		It("🧪 should: deliver error at the failing directory", func(ctx SpecContext) {
+			errFailed := errors.New("read failed")
+			failing := filepath.Join(lab.Static.RetroWave, "College")
+
+			type failure struct {
+				path string
+				err  error
+			}
+
+			run := func(settings ...pref.Option) ([]string, []failure) {
+				failures := []failure{}
+				visited, err := navigate(ctx, enums.SubscribeUniversal,
+					append(settings,
+						agenor.WithHookReadDirectory(func(rsys fs.ReadDirFS, dirname string) ([]fs.DirEntry, error) {
+							if dirname == failing {
+								return nil, errFailed
+							}
+
+							return pref.DefaultReadEntriesHook(rsys, dirname)
+						}),
+						agenor.WithSkipHandler(pref.Asker(func(current *core.Node,
+							_ core.DirectoryContents, err error,
+						) (enums.SkipTraversal, error) {
+							if err != nil {
+								failures = append(failures, failure{path: current.Path, err: err})
+							}
+
+							return enums.SkipNoneTraversal, nil
+						})),
+					)...,
+				)
+				Expect(err).To(Succeed())
+
+				return visited, failures
+			}
+
+			expectedVisited, expectedFailures := run()
+			visited, failures := run(agenor.WithReadAhead(3))
+
+			Expect(expectedFailures).To(HaveLen(1))
+			Expect(failures).To(Equal(expectedFailures))
+			Expect(failures[0].path).To(Equal(failing))
+			Expect(errors.Is(failures[0].err, errFailed)).To(BeTrue())
+			Expect(visited).To(Equal(expectedVisited))
+		})
+	})
+})
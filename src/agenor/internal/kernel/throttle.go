@@ -0,0 +1,201 @@
+package kernel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+)
+
+// bucket is a token bucket that limits the rate at which a resource is
+// consumed. The bucket holds up to a second's worth of tokens, so short
+// bursts are permitted. A request that exceeds the tokens available is
+// still granted, but the caller is made to wait until the deficit has
+// been replenished; this allows a request larger than the capacity, eg a
+// file bigger than the bytes rate, to proceed. The rate actually achieved,
+// measured over the most recent second, is recorded into the gauge.
+type bucket struct {
+	mux      sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+	window   time.Time
+	count    uint64
+	measured bool
+	gauge    *core.NavigationMetric
+}
+
+func newBucket(rate uint64, gauge *core.NavigationMetric) *bucket {
+	if rate == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	return &bucket{
+		rate:     float64(rate),
+		capacity: float64(rate),
+		tokens:   float64(rate),
+		last:     now,
+		window:   now,
+		gauge:    gauge,
+	}
+}
+
+// take consumes n tokens, waiting until they have been replenished if
+// the bucket does not contain enough. The wait is abandoned if the
+// context is cancelled.
+func (b *bucket) take(ctx context.Context, n uint64) error {
+	if b == nil || n == 0 {
+		return nil
+	}
+
+	b.mux.Lock()
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens -= float64(n)
+	b.measure(now, n)
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mux.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// measure accumulates the tokens consumed within the current window and
+// once the window spans a second, records the rate into the gauge.
+func (b *bucket) measure(now time.Time, n uint64) {
+	b.count += n
+
+	if elapsed := now.Sub(b.window); elapsed >= time.Second {
+		b.record(elapsed)
+		b.measured = true
+	}
+}
+
+// record sets the gauge to the rate over the current window, which then
+// starts afresh.
+func (b *bucket) record(elapsed time.Duration) {
+	b.gauge.Set(core.MetricValue(float64(b.count) / elapsed.Seconds()))
+	b.count = 0
+	b.window = b.window.Add(elapsed)
+}
+
+// flush records the rate over the partial window, if a full window
+// has not yet been measured, so that the gauge reflects navigations
+// that take less than a second.
+func (b *bucket) flush() {
+	if b == nil {
+		return
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if elapsed := time.Since(b.window); !b.measured && b.count > 0 && elapsed > 0 {
+		b.record(elapsed)
+	}
+}
+
+// throttle limits the rate at which nodes are presented to the client,
+// directories are read and the bytes of client I/O, as requested via
+// pref.WithThrottle. A nil throttle does not limit anything.
+type throttle struct {
+	ctx   context.Context
+	nodes *bucket
+	reads *bucket
+	bytes *bucket
+}
+
+func newThrottle(o *pref.Options, supervisor *enclave.Supervisor) *throttle {
+	if !o.Throttle.IsThrottleActive() {
+		return nil
+	}
+
+	gauge := func(rate uint64, mt enums.Metric) *bucket {
+		if rate == 0 {
+			return nil
+		}
+
+		return newBucket(rate, supervisor.Single(mt))
+	}
+
+	return &throttle{
+		ctx:   context.Background(),
+		nodes: gauge(uint64(o.Throttle.Nodes), enums.MetricNodeRate),
+		reads: gauge(uint64(o.Throttle.Reads), enums.MetricReadRate),
+		bytes: gauge(o.Throttle.Bytes, enums.MetricByteRate),
+	}
+}
+
+// start binds the throttle to the context of a top level navigation,
+// so that waiting is abandoned when the navigation is cancelled. It must
+// always be paired with stop.
+func (t *throttle) start(ctx context.Context) {
+	if t == nil {
+		return
+	}
+
+	t.ctx = ctx
+}
+
+// stop records the rates of a navigation that completed within the first
+// measurement window.
+func (t *throttle) stop() {
+	if t == nil {
+		return
+	}
+
+	t.nodes.flush()
+	t.reads.flush()
+	t.bytes.flush()
+}
+
+// present waits until the next node may be presented to the client.
+func (t *throttle) present() error {
+	if t == nil {
+		return nil
+	}
+
+	return t.nodes.take(t.ctx, 1)
+}
+
+// transfer waits until the client may perform the I/O of the node, to
+// which the size of a file counts.
+func (t *throttle) transfer(node *core.Node) error {
+	if t == nil || node.IsDirectory() || node.Info == nil {
+		return nil
+	}
+
+	return t.bytes.take(t.ctx, uint64(max(node.Info.Size(), 0))) //nolint:gosec // ok
+}
+
+// read waits until a directory may be read.
+func (t *throttle) read() error {
+	if t == nil {
+		return nil
+	}
+
+	return t.reads.take(t.ctx, 1)
+}
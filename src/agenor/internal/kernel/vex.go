@@ -1,11 +1,17 @@
 package kernel
 
+import (
+	"github.com/snivilised/jaywalk/src/agenor/core"
+)
+
 type (
 	vexation interface {
 		ancestor() string
 		vapour() inspection
 		cause() string
 		magnitude() string
+		frontier() []string
+		level() core.TraversalDepth
 	}
 
 	vex struct {
@@ -14,6 +20,8 @@ type (
 		vap      inspection
 		catalyst string
 		mag      string
+		pending  []string
+		depth    core.TraversalDepth
 	}
 )
 
@@ -36,3 +44,11 @@ func (v *vex) cause() string {
 func (v *vex) magnitude() string {
 	return v.mag
 }
+
+func (v *vex) frontier() []string {
+	return v.pending
+}
+
+func (v *vex) level() core.TraversalDepth {
+	return v.depth
+}
@@ -97,6 +97,26 @@ func (p *Periscope) Descend(maximum core.TraversalDepth) bool {
 	return true
 }
 
+// Permits determines whether a subsequent Descend would succeed without
+// modifying the depth; ie, whether the children of the current directory
+// are within the maximum depth.
+func (p *Periscope) Permits(maximum core.TraversalDepth) bool {
+	return maximum == 0 || p.depth <= maximum
+}
+
+// Level returns the raw depth of the periscope, which is the depth of
+// the children of the current directory.
+func (p *Periscope) Level() core.TraversalDepth {
+	return p.depth
+}
+
+// Restore resets the periscope to the raw depth previously acquired via
+// Level. This is required when navigating breadth first, where successive
+// nodes are not related by descent.
+func (p *Periscope) Restore(level core.TraversalDepth) {
+	p.depth = level
+}
+
 // Ascend decrements the depth of the periscope, allowing the traversal to
 // move back up the directory structure. This is typically called after
 // processing a directory's contents, signaling that the traversal is moving
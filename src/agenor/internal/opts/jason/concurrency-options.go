@@ -7,5 +7,9 @@ type (
 		// pool used for concurrent traversal sessions requested by using
 		// the Sprint function.
 		NoW uint `json:"no-of-workers"`
+
+		// ReadAhead specifies the number of go-routines used to prefetch
+		// sub-directory contents.
+		ReadAhead uint `json:"read-ahead"`
 	}
 )
@@ -15,6 +15,26 @@ type (
 		Directory FilterDef
 	}
 
+	// CompoundFilterDef allows for the definition of a filter that combines
+	// any number of filter definitions.
+	CompoundFilterDef struct {
+		// Mode specifies how the constituent filters are combined (mandatory)
+		Mode enums.CompoundMode `json:"compound-mode"`
+
+		// Filters are the constituent filter definitions (mandatory)
+		Filters []FilterDef `json:"filters"`
+	}
+
+	// NamedFilterDef identifies a custom filter by the name under which its
+	// factory was registered, along with its serialised parameters.
+	NamedFilterDef struct {
+		// Name of the registered filter factory (mandatory)
+		Name string `json:"filter-name"`
+
+		// Params serialised parameters passed to the factory (optional)
+		Params string `json:"filter-params"`
+	}
+
 	// FilterDef defines a filter that can be applied to a file system entry.
 	// The filter definition includes the type of filter, a pattern that defines
 	// the filter, and other optional fields that provide additional information
@@ -55,6 +75,10 @@ type (
 		// all other fields are redundant, since the filter definitions inside
 		// Poly should be referred to instead.
 		Poly *PolyFilterDef
+
+		// Compound allows for the definition of a filter that combines other
+		// filter definitions, required when Type is FilterTypeCompound.
+		Compound *CompoundFilterDef
 	}
 
 	// ChildFilterDef defines the filter that should be applied to the children
@@ -107,6 +131,9 @@ type (
 		// all other fields are redundant, since the filter definitions inside
 		// Poly should be referred to instead.
 		Poly *PolyFilterDef
+
+		// Named identifies a custom sampling filter
+		Named *NamedFilterDef
 	}
 
 	// FilterOptions is a container for the different types of filters that can be
@@ -129,5 +156,14 @@ type (
 		// Sample is the filter used for sampling
 		//
 		Sample *SampleFilterDef
+
+		// Prune is the filter that determines which directories are not
+		// descended
+		//
+		Prune *FilterDef
+
+		// Named identifies the custom filter
+		//
+		Named *NamedFilterDef
 	}
 )
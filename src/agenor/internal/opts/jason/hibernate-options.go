@@ -1,5 +1,9 @@
 package jason
 
+import (
+	"time"
+)
+
 type (
 	// HibernationBehaviour defines the behavioural aspects of hibernation
 	HibernationBehaviour struct {
@@ -12,6 +16,26 @@ type (
 		InclusiveSleep bool `json:"hibernate-inclusive-sleep"`
 	}
 
+	// HibernateTriggers defines hibernation conditions based on counts,
+	// depth and time
+	HibernateTriggers struct {
+		// WakeAfterNodes wakes once this number of nodes have been seen
+		WakeAfterNodes uint `json:"hibernate-wake-after-nodes"`
+
+		// SleepAfterInvokes sleeps once the client has been invoked this
+		// number of times
+		SleepAfterInvokes uint `json:"hibernate-sleep-after-invokes"`
+
+		// WakeAtDepth wakes at the first node at this depth
+		WakeAtDepth uint `json:"hibernate-wake-at-depth"`
+
+		// SleepAtDepth sleeps at the first node at this depth
+		SleepAtDepth uint `json:"hibernate-sleep-at-depth"`
+
+		// SleepAfter sleeps once this duration has elapsed
+		SleepAfter time.Duration `json:"hibernate-sleep-after"`
+	}
+
 	// HibernateOptions contains options relating to hibernation
 	HibernateOptions struct {
 		// WakeAt defines a filter for hibernation wake condition
@@ -20,6 +44,12 @@ type (
 		// SleepAt defines a filter for hibernation sleep condition
 		SleepAt *FilterDef
 
+		// ToggleAt defines a filter for hibernation toggle condition
+		ToggleAt *FilterDef
+
+		// Triggers defines wake and sleep conditions not based on filters
+		Triggers HibernateTriggers
+
 		// Behaviour contains hibernation behavioural aspects
 		Behaviour HibernationBehaviour
 	}
@@ -1,6 +1,9 @@
 package jason
 
-import "github.com/snivilised/jaywalk/src/agenor/core"
+import (
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+)
 
 type (
 	// SubPathBehaviour SubPath behaviours relating to handling of sub-path calculation
@@ -34,6 +37,18 @@ type (
 		// only the files in a specified directory.
 		//
 		NoRecurse bool
+
+		// OneFileSystem prevents the navigator from descending into directories
+		// that reside on a different device to the tree.
+		//
+		OneFileSystem bool
+	}
+
+	// LinkBehaviour Links controls how symbolic links are handled
+	LinkBehaviour struct {
+		// Mode defines whether links are ignored, reported or followed
+		//
+		Mode enums.LinkMode
 	}
 
 	// NavigationBehaviours is the collection of behaviours relating to navigation.
@@ -49,5 +64,13 @@ type (
 		// Cascade controls how deep to navigate
 		//
 		Cascade CascadeBehaviour
+
+		// Links controls how symbolic links are handled
+		//
+		Links LinkBehaviour
+
+		// Order defines the order in which nodes are visited
+		//
+		Order enums.TraversalOrder
 	}
 )
@@ -18,5 +18,8 @@ type (
 
 		// Concurrency contains options relating concurrency
 		Concurrency ConcurrencyOptions
+
+		// Throttle contains options relating to rate limiting
+		Throttle ThrottleOptions
 	}
 )
@@ -30,5 +30,11 @@ type (
 		// NoOf specifies number of items required in each sample (only applies
 		// when not using Custom iterator options)
 		NoOf EntryQuantities
+
+		// Seed determines the entries selected by random sampling
+		Seed uint64 `json:"seed"`
+
+		// Stride specifies the interval k, when selecting every k-th entry
+		Stride uint `json:"stride"`
 	}
 )
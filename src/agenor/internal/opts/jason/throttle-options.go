@@ -0,0 +1,17 @@
+package jason
+
+type (
+	// ThrottleOptions specifies the rates at which the navigator is permitted
+	// to consume resources
+	ThrottleOptions struct {
+		// Nodes specifies the maximum number of nodes per second that may be
+		// presented to the client.
+		Nodes uint `json:"nodes-per-second"`
+
+		// Reads specifies the maximum number of directory reads per second.
+		Reads uint `json:"reads-per-second"`
+
+		// Bytes specifies the maximum number of bytes of client I/O per second.
+		Bytes uint64 `json:"bytes-per-second"`
+	}
+)
@@ -0,0 +1,366 @@
+package persist
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/locale"
+	nef "github.com/snivilised/nefilim"
+)
+
+type (
+	// Checkpoint describes a file containing saved navigation state, as
+	// identified by its name, see CheckpointName.
+	Checkpoint struct {
+		// Path is the full path of the file
+		Path string
+
+		// Magnitude of the navigation that saved the file, ie prime or resume
+		Magnitude string
+
+		// Cause of the save, eg panic, interrupt or checkpoint
+		Cause string
+
+		// Saved is the time at which the file was saved
+		Saved time.Time
+
+		// Format is the persistence format implied by the file's extension
+		Format enums.PersistenceFormat
+	}
+
+	// CheckpointDetail is the content of a checkpoint file
+	CheckpointDetail struct {
+		Checkpoint
+
+		// Tree is the root of the saved navigation
+		Tree string
+
+		// CurrentPath is the path of the node from which navigation resumes
+		CurrentPath string
+
+		// Subscription of the saved navigation
+		Subscription enums.Subscription
+
+		// Depth of the current path
+		Depth core.TraversalDepth
+
+		// Metrics collected up to the point of the save
+		Metrics core.Metrics
+
+		// Summary describes the saved options that are in effect, one
+		// setting per entry
+		Summary []string
+
+		// O are the saved options
+		O *pref.Options
+	}
+
+	// Catalogue provides access to the checkpoint files within the resume
+	// directory of an admin path.
+	Catalogue struct {
+		fS        tfs.TraversalFS
+		directory string
+	}
+
+	// remover is implemented by file systems that are able to delete
+	// files, which is required in order to prune checkpoints.
+	remover interface {
+		Remove(name string) error
+	}
+)
+
+// ResumeDirectory returns the directory within the admin path in which
+// the navigation state is saved.
+func ResumeDirectory(admin string, calc nef.PathCalc) string {
+	directory := nef.ResolvePath(admin)
+	if !strings.HasSuffix(directory, core.ResumeTail) {
+		directory = calc.Join(directory, core.ResumeTail)
+	}
+
+	return directory
+}
+
+// NewCatalogue creates a catalogue of the checkpoint files saved within
+// the admin path.
+func NewCatalogue(fS tfs.TraversalFS, admin string) *Catalogue {
+	return &Catalogue{
+		fS:        fS,
+		directory: ResumeDirectory(admin, fS.Calc()),
+	}
+}
+
+// List returns the checkpoints in the catalogue, most recent first. Files
+// not named as a checkpoint are ignored.
+func (c *Catalogue) List() ([]*Checkpoint, error) {
+	entries, err := c.fS.ReadDir(c.directory)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []*Checkpoint{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := make([]*Checkpoint, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if checkpoint, ok := c.parse(entry.Name()); ok {
+			checkpoints = append(checkpoints, checkpoint)
+		}
+	}
+
+	slices.SortStableFunc(checkpoints, func(a, b *Checkpoint) int {
+		if n := b.Saved.Compare(a.Saved); n != 0 {
+			return n
+		}
+
+		return strings.Compare(b.Path, a.Path)
+	})
+
+	return checkpoints, nil
+}
+
+// CheckpointName returns the name of the file into which navigation state
+// saved at the time specified is written, ie
+// <magnitude>.<cause>.<timestamp>.<microseconds>.<extension>. The sub-second
+// component ensures that checkpoints saved in quick succession are distinct
+// and are listed in the order they were saved.
+func CheckpointName(magnitude, cause string,
+	saved time.Time,
+	format enums.PersistenceFormat,
+) string {
+	return fmt.Sprintf("%v.%v.%v.%0*d.%v",
+		magnitude, cause, saved.Format(core.FileSystemTimeFormat),
+		fractionWidth, saved.Nanosecond()/int(time.Microsecond),
+		NewCodec(format).Extension(),
+	)
+}
+
+// fractionWidth is the number of digits of the sub-second component of a
+// checkpoint name
+const fractionWidth = 6
+
+func (c *Catalogue) parse(name string) (*Checkpoint, bool) {
+	const (
+		parts  = 5
+		legacy = 4
+	)
+
+	// checkpoints saved before the sub-second component was introduced
+	// are named <magnitude>.<cause>.<timestamp>.<extension>; these are
+	// still recognised, with a fraction of 0.
+	fields := strings.Split(name, ".")
+	switch {
+	case len(fields) == legacy:
+		fields = []string{fields[0], fields[1], fields[2], "", fields[3]}
+	case len(fields) != parts || len(fields[3]) != fractionWidth:
+		return nil, false
+	}
+
+	saved, err := time.ParseInLocation(core.FileSystemTimeFormat, fields[2], time.Local)
+	if err != nil {
+		return nil, false
+	}
+
+	var fraction uint64
+
+	if fields[3] != "" {
+		if fraction, err = strconv.ParseUint(fields[3], 10, 32); err != nil {
+			return nil, false
+		}
+	}
+
+	saved = saved.Add(time.Duration(fraction) * time.Microsecond)
+
+	path := c.fS.Calc().Join(c.directory, name)
+
+	return &Checkpoint{
+		Path:      path,
+		Magnitude: fields[0],
+		Cause:     fields[1],
+		Saved:     saved,
+		Format:    Detect(path, nil).Format(),
+	}, true
+}
+
+// Inspect loads the checkpoint at path and returns its detail.
+func (c *Catalogue) Inspect(path string) (*CheckpointDetail, error) {
+	checkpoint, ok := c.parse(filepath.Base(path))
+	if !ok {
+		checkpoint = &Checkpoint{
+			Path: path,
+		}
+	}
+
+	checkpoint.Path = path
+
+	result, err := Unmarshal(&UnmarshalRequest{
+		Restore: &enclave.RestoreState{
+			Path: path,
+			FS:   c.fS,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Active == nil || result.Active.Tree == "" {
+		return nil, locale.ErrUsageMissingTreePath
+	}
+
+	checkpoint.Format = result.Format
+
+	return &CheckpointDetail{
+		Checkpoint:   *checkpoint,
+		Tree:         result.Active.Tree,
+		CurrentPath:  result.Active.CurrentPath,
+		Subscription: result.Active.Subscription,
+		Depth:        result.Active.Depth,
+		Metrics:      result.Active.Metrics,
+		Summary:      summarise(result.O),
+		O:            result.O,
+	}, nil
+}
+
+// Validate checks that the checkpoint at path can be loaded for resume.
+func (c *Catalogue) Validate(path string) error {
+	_, err := c.Inspect(path)
+
+	return err
+}
+
+// Latest returns the most recent valid checkpoint saved for the tree.
+func (c *Catalogue) Latest(tree string) (*Checkpoint, error) {
+	checkpoints, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	tree = filepath.Clean(tree)
+
+	for _, checkpoint := range checkpoints {
+		detail, err := c.Inspect(checkpoint.Path)
+		if err != nil {
+			continue
+		}
+
+		if filepath.Clean(detail.Tree) == tree {
+			return checkpoint, nil
+		}
+	}
+
+	return nil, locale.NewResumeFileNotFoundError(tree)
+}
+
+// Prune removes the checkpoints that are invalid, along with the valid
+// checkpoints in excess of the keep most recent, returning the paths of
+// the files removed.
+func (c *Catalogue) Prune(keep uint) ([]string, error) {
+	r, ok := c.fS.(remover)
+	if !ok {
+		return nil, errors.ErrUnsupported
+	}
+
+	checkpoints, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		kept    uint
+		removed = []string{}
+	)
+
+	for _, checkpoint := range checkpoints {
+		if kept < keep && c.Validate(checkpoint.Path) == nil {
+			kept++
+
+			continue
+		}
+
+		if err := r.Remove(checkpoint.Path); err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, checkpoint.Path)
+	}
+
+	return removed, nil
+}
+
+func summarise(o *pref.Options) []string {
+	summary := []string{
+		fmt.Sprintf("order: %v", o.Behaviours.Order),
+	}
+
+	if o.Behaviours.Cascade.Depth > 0 {
+		summary = append(summary, fmt.Sprintf("depth: %v", o.Behaviours.Cascade.Depth))
+	}
+
+	if o.Behaviours.Cascade.NoRecurse {
+		summary = append(summary, "no-recurse")
+	}
+
+	if def := o.Filter.Node; def != nil {
+		summary = append(summary, fmt.Sprintf("node-filter: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if def := o.Filter.Child; def != nil {
+		summary = append(summary, fmt.Sprintf("child-filter: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if def := o.Filter.Prune; def != nil {
+		summary = append(summary, fmt.Sprintf("prune-filter: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if named := o.Filter.Named; named != nil {
+		summary = append(summary, fmt.Sprintf("named-filter: %v '%v'", named.Name, named.Params))
+	}
+
+	if def := o.Filter.Sample; def != nil {
+		summary = append(summary, fmt.Sprintf("sample-filter: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if o.Sampling.Type != enums.SampleTypeUndefined {
+		summary = append(summary, fmt.Sprintf("sampling: %v (files: %v, directories: %v)",
+			o.Sampling.Type, o.Sampling.NoOf.Files, o.Sampling.NoOf.Directories,
+		))
+	}
+
+	if def := o.Hibernate.WakeAt; def != nil {
+		summary = append(summary, fmt.Sprintf("wake-at: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if def := o.Hibernate.SleepAt; def != nil {
+		summary = append(summary, fmt.Sprintf("sleep-at: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if def := o.Hibernate.ToggleAt; def != nil {
+		summary = append(summary, fmt.Sprintf("toggle-at: %v '%v'", def.Type, def.Pattern))
+	}
+
+	if t := o.Hibernate.Triggers; t.IsWakeActive() || t.IsSleepActive() {
+		summary = append(summary, fmt.Sprintf("hibernate-triggers: %+v", t))
+	}
+
+	if o.Concurrency.NoW > 0 {
+		summary = append(summary, fmt.Sprintf("workers: %v", o.Concurrency.NoW))
+	}
+
+	return summary
+}
@@ -0,0 +1,253 @@
+package persist_test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/opts"
+	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Catalogue", Ordered, func() {
+	const (
+		admin = "admin"
+		north = "/music/north"
+		south = "/music/south"
+	)
+
+	var (
+		fS        *luna.MemFS
+		directory string
+		catalogue *persist.Catalogue
+		epoch     = time.Date(2026, time.March, 1, 12, 0, 0, 0, time.Local)
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register()).To(Succeed())
+	})
+
+	BeforeEach(func() {
+		fS = luna.NewMemFS()
+		directory = persist.ResumeDirectory(admin, fS.Calc())
+		Expect(fS.MakeDirAll(directory, lab.Perms.Dir)).To(Succeed())
+
+		catalogue = persist.NewCatalogue(fS, admin)
+	})
+
+	// saveAt writes a checkpoint for the tree, saved at the time specified.
+	saveAt := func(tree, cause string, saved time.Time, format enums.PersistenceFormat) string {
+		o, _, err := opts.Get(
+			pref.WithDepth(3),
+			pref.WithPersistFormat(format),
+			pref.WithFilter(&pref.FilterOptions{
+				Node: &core.FilterDef{
+					Type:    enums.FilterTypeGlob,
+					Pattern: flac,
+					Scope:   enums.ScopeFile,
+				},
+			}),
+		)
+		Expect(err).To(Succeed())
+
+		metrics := enclave.NewSupervisor().Many(enums.MetricNoFilesInvoked)
+		metrics[enums.MetricNoFilesInvoked].Times(7)
+
+		path := fS.Calc().Join(directory, persist.CheckpointName("prime",
+			cause, saved, format,
+		))
+
+		_, err = persist.Marshal(&persist.MarshalRequest{
+			O: o,
+			Active: &core.ActiveState{
+				Tree:         tree,
+				Subscription: enums.SubscribeFiles,
+				CurrentPath:  tree + "/a/b",
+				Depth:        2,
+				Metrics:      metrics,
+			},
+			Path: path,
+			Perm: lab.Perms.File,
+			FS:   fS,
+		})
+		Expect(err).To(Succeed())
+
+		return path
+	}
+
+	// save writes a checkpoint for the tree, saved the number of minutes
+	// after the epoch.
+	save := func(tree, cause string, minutes int, format enums.PersistenceFormat) string {
+		return saveAt(tree, cause, epoch.Add(time.Duration(minutes)*time.Minute), format)
+	}
+
+	When("resume directory does not exist", func() {
+		It("🧪 should: list no checkpoints", func() {
+			checkpoints, err := persist.NewCatalogue(fS, "elsewhere").List()
+
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(BeEmpty())
+		})
+	})
+
+	Context("list", func() {
+		It("🧪 should: list checkpoints, most recent first", func() {
+			first := save(north, "checkpoint", 1, enums.PersistJSON)
+			second := save(south, "interrupt", 2, enums.PersistYAML)
+			third := save(north, "panic", 3, enums.PersistGob)
+			Expect(fS.WriteFile(fS.Calc().Join(directory, "notes.txt"),
+				[]byte("not a checkpoint"), lab.Perms.File,
+			)).To(Succeed())
+
+			checkpoints, err := catalogue.List()
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(HaveLen(3))
+
+			Expect(checkpoints[0].Path).To(Equal(third))
+			Expect(checkpoints[0].Cause).To(Equal("panic"))
+			Expect(checkpoints[0].Format).To(Equal(enums.PersistGob))
+			Expect(checkpoints[1].Path).To(Equal(second))
+			Expect(checkpoints[1].Format).To(Equal(enums.PersistYAML))
+			Expect(checkpoints[2].Path).To(Equal(first))
+			Expect(checkpoints[2].Magnitude).To(Equal("prime"))
+			Expect(checkpoints[2].Saved).To(BeTemporally("==", epoch.Add(time.Minute)))
+		})
+	})
+
+	When("checkpoint name has no sub-second component", func() {
+		It("🧪 should: list legacy checkpoint", func() {
+			saved := epoch.Add(time.Minute)
+			current := save(north, "checkpoint", 2, enums.PersistJSON)
+			legacy := fS.Calc().Join(directory, fmt.Sprintf("prime.panic.%v.json",
+				saved.Format(core.FileSystemTimeFormat),
+			))
+			content, err := fS.ReadFile(current)
+			Expect(err).To(Succeed())
+			Expect(fS.WriteFile(legacy, content, lab.Perms.File)).To(Succeed())
+
+			checkpoints, err := catalogue.List()
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(HaveLen(2))
+			Expect(checkpoints[0].Path).To(Equal(current))
+			Expect(checkpoints[1].Path).To(Equal(legacy))
+			Expect(checkpoints[1].Cause).To(Equal("panic"))
+			Expect(checkpoints[1].Format).To(Equal(enums.PersistJSON))
+			Expect(checkpoints[1].Saved).To(BeTemporally("==", saved))
+		})
+
+		It("🧪 should: list legacy checkpoint fixture", func() {
+			rS := tfs.New()
+			admin := rS.Calc().Join(lab.GetJSONDir(), "marshal", "home", "prodigy")
+
+			checkpoints, err := persist.NewCatalogue(rS, admin).List()
+			Expect(err).To(Succeed())
+
+			names := lo.Map(checkpoints, func(c *persist.Checkpoint, _ int) string {
+				return filepath.Base(c.Path)
+			})
+			Expect(names).To(ContainElement("prime.panic.2024-11-14_15-04-05.json"))
+		})
+	})
+
+	When("saved within the same second", func() {
+		It("🧪 should: list checkpoints in the order saved", func() {
+			first := saveAt(north, "interrupt", epoch.Add(time.Millisecond), enums.PersistJSON)
+			second := saveAt(north, "checkpoint", epoch.Add(2*time.Millisecond), enums.PersistJSON)
+
+			checkpoints, err := catalogue.List()
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(HaveLen(2))
+			Expect(checkpoints[0].Path).To(Equal(second))
+			Expect(checkpoints[1].Path).To(Equal(first))
+			Expect(checkpoints[1].Saved).To(BeTemporally("==", epoch.Add(time.Millisecond)))
+		})
+	})
+
+	Context("inspect", func() {
+		It("🧪 should: describe saved state", func() {
+			detail, err := catalogue.Inspect(save(north, "checkpoint", 1, enums.PersistJSON))
+			Expect(err).To(Succeed())
+
+			Expect(detail.Tree).To(Equal(north))
+			Expect(detail.CurrentPath).To(Equal(north + "/a/b"))
+			Expect(detail.Subscription).To(Equal(enums.SubscribeFiles))
+			Expect(detail.Depth).To(BeEquivalentTo(2))
+			Expect(detail.Metrics[enums.MetricNoFilesInvoked].Value()).To(BeEquivalentTo(7))
+			Expect(detail.Summary).To(ContainElements(
+				"depth: 3",
+				fmt.Sprintf("node-filter: %v '%v'", enums.FilterTypeGlob, flac),
+			))
+		})
+	})
+
+	Context("validate", func() {
+		When("checkpoint is corrupt", func() {
+			It("🧪 should: fail validation", func() {
+				path := save(north, "checkpoint", 1, enums.PersistJSON)
+				Expect(fS.WriteFile(path, []byte("{ corrupt"), lab.Perms.File)).To(Succeed())
+
+				Expect(catalogue.Validate(path)).NotTo(Succeed())
+			})
+		})
+	})
+
+	Context("latest", func() {
+		It("🧪 should: select most recent valid checkpoint for tree", func() {
+			save(north, "checkpoint", 1, enums.PersistJSON)
+			expected := save(north, "checkpoint", 2, enums.PersistJSON)
+			save(south, "checkpoint", 3, enums.PersistJSON)
+			corrupt := save(north, "checkpoint", 4, enums.PersistJSON)
+			Expect(fS.WriteFile(corrupt, []byte("{ corrupt"), lab.Perms.File)).To(Succeed())
+
+			checkpoint, err := catalogue.Latest(north + "/")
+			Expect(err).To(Succeed())
+			Expect(checkpoint.Path).To(Equal(expected))
+		})
+
+		When("no checkpoint for tree", func() {
+			It("🧪 should: return not found error", func() {
+				save(south, "checkpoint", 1, enums.PersistJSON)
+
+				_, err := catalogue.Latest(north)
+
+				var notFound *locale.ResumeFileNotFoundError
+				Expect(errors.As(err, &notFound)).To(BeTrue())
+				Expect(notFound.Tree).To(Equal(north))
+			})
+		})
+	})
+
+	Context("prune", func() {
+		It("🧪 should: remove invalid and all but most recent", func() {
+			first := save(north, "checkpoint", 1, enums.PersistJSON)
+			second := save(south, "checkpoint", 2, enums.PersistJSON)
+			third := save(north, "checkpoint", 3, enums.PersistJSON)
+			corrupt := save(north, "checkpoint", 4, enums.PersistJSON)
+			Expect(fS.WriteFile(corrupt, []byte("{ corrupt"), lab.Perms.File)).To(Succeed())
+
+			removed, err := catalogue.Prune(2)
+			Expect(err).To(Succeed())
+			Expect(removed).To(ConsistOf(corrupt, first))
+
+			checkpoints, err := catalogue.List()
+			Expect(err).To(Succeed())
+			Expect(checkpoints).To(HaveLen(2))
+			Expect(checkpoints[0].Path).To(Equal(third))
+			Expect(checkpoints[1].Path).To(Equal(second))
+		})
+	})
+})
@@ -0,0 +1,197 @@
+package persist
+
+import (
+	"bytes"
+	"encoding/gob"
+	ejson "encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"go.yaml.in/yaml/v3"
+)
+
+type (
+	// Codec encodes and decodes the persisted navigation state in a
+	// particular format.
+	Codec interface {
+		// Format returns the persistence format implemented by the codec
+		Format() enums.PersistenceFormat
+
+		// Extension returns the extension, without the leading dot, of the
+		// files written by the codec
+		Extension() string
+
+		// Encode encodes the result into the codec's format
+		Encode(result *MarshalResult) ([]byte, error)
+
+		// Decode decodes data in the codec's format into the result
+		Decode(data []byte, result *MarshalResult) error
+	}
+
+	jsonCodec struct{}
+
+	// yamlCodec bridges via JSON, so that the persisted representation
+	// is the same as that of JSON, including custom marshaling.
+	yamlCodec struct{}
+
+	// gobCodec prefixes the encoded data with a header, since gob data
+	// is not otherwise identifiable.
+	gobCodec struct{}
+)
+
+var gobHeader = []byte("agenor:gob\n")
+
+// NewCodec returns the codec for the format, which defaults to JSON when
+// the format is undefined.
+func NewCodec(format enums.PersistenceFormat) Codec {
+	switch format {
+	case enums.PersistYAML:
+		return yamlCodec{}
+	case enums.PersistGob:
+		return gobCodec{}
+	case enums.PersistJSON, enums.PersistUndefined:
+	}
+
+	return jsonCodec{}
+}
+
+// Detect determines the codec with which data read from path was encoded.
+// The header of the data takes precedence, followed by the extension of the
+// path. Failing that, data that looks like a JSON object is assumed to be
+// JSON, otherwise YAML.
+func Detect(path string, data []byte) Codec {
+	if bytes.HasPrefix(data, gobHeader) {
+		return gobCodec{}
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(path), ".")) {
+	case "json":
+		return jsonCodec{}
+	case "yaml", "yml":
+		return yamlCodec{}
+	case "gob":
+		return gobCodec{}
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return jsonCodec{}
+	}
+
+	return yamlCodec{}
+}
+
+func (jsonCodec) Format() enums.PersistenceFormat {
+	return enums.PersistJSON
+}
+
+func (jsonCodec) Extension() string {
+	return "json"
+}
+
+func (jsonCodec) Encode(result *MarshalResult) ([]byte, error) {
+	return ejson.MarshalIndent(
+		result,
+		JSONMarshalNoPrefix, JSONMarshal2SpacesIndent,
+	)
+}
+
+func (jsonCodec) Decode(data []byte, result *MarshalResult) error {
+	return ejson.Unmarshal(data, result)
+}
+
+func (yamlCodec) Format() enums.PersistenceFormat {
+	return enums.PersistYAML
+}
+
+func (yamlCodec) Extension() string {
+	return "yaml"
+}
+
+func (yamlCodec) Encode(result *MarshalResult) ([]byte, error) {
+	data, err := ejson.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := ejson.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(numbers(document))
+}
+
+func (yamlCodec) Decode(data []byte, result *MarshalResult) error {
+	var document any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+
+	bridge, err := ejson.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	return ejson.Unmarshal(bridge, result)
+}
+
+// numbers replaces the JSON numbers within the document with integers where
+// possible, so that they are represented as numbers rather than strings in
+// YAML, without loss of precision.
+func numbers(document any) any {
+	switch value := document.(type) {
+	case map[string]any:
+		for k, v := range value {
+			value[k] = numbers(v)
+		}
+
+	case []any:
+		for i, v := range value {
+			value[i] = numbers(v)
+		}
+
+	case ejson.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+
+		if u, err := strconv.ParseUint(value.String(), 10, 64); err == nil {
+			return u
+		}
+
+		if f, err := value.Float64(); err == nil {
+			return f
+		}
+	}
+
+	return document
+}
+
+func (gobCodec) Format() enums.PersistenceFormat {
+	return enums.PersistGob
+}
+
+func (gobCodec) Extension() string {
+	return "gob"
+}
+
+func (gobCodec) Encode(result *MarshalResult) ([]byte, error) {
+	buffer := bytes.NewBuffer(bytes.Clone(gobHeader))
+
+	if err := gob.NewEncoder(buffer).Encode(result); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (gobCodec) Decode(data []byte, result *MarshalResult) error {
+	return gob.NewDecoder(
+		bytes.NewReader(bytes.TrimPrefix(data, gobHeader)),
+	).Decode(result)
+}
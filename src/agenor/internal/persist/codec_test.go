@@ -0,0 +1,154 @@
+package persist_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
+	"github.com/snivilised/jaywalk/src/agenor/internal/opts"
+	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("Codec", func() {
+	const (
+		huge = core.MetricValue(1<<63 + 1)
+	)
+
+	var (
+		fS *luna.MemFS
+	)
+
+	BeforeEach(func() {
+		fS = luna.NewMemFS()
+		Expect(fS.MakeDirAll(destination, lab.Perms.Dir)).To(Succeed())
+	})
+
+	save := func(format enums.PersistenceFormat, name string, frontier ...string) string {
+		o, _, err := opts.Get(
+			pref.WithPersistFormat(format),
+			pref.WithDepth(4),
+			pref.WithFilter(&pref.FilterOptions{
+				Node: &core.FilterDef{
+					Type:        enums.FilterTypeGlob,
+					Description: "flac files",
+					Pattern:     flac,
+					Scope:       enums.ScopeFile,
+				},
+			}),
+		)
+		Expect(err).To(Succeed())
+
+		supervisor := enclave.NewSupervisor()
+		metrics := supervisor.Many(
+			enums.MetricNoFilesInvoked,
+			enums.MetricNoFileBytesInvoked,
+		)
+		metrics[enums.MetricNoFilesInvoked].Times(42)
+		metrics[enums.MetricNoFileBytesInvoked].Times(huge)
+
+		path := filepath.Join(destination, name)
+		_, err = persist.Marshal(&persist.MarshalRequest{
+			O: o,
+			Active: &core.ActiveState{
+				Tree:        destination,
+				Hibernation: enums.HibernationPending,
+				CurrentPath: "/top/a/b/c",
+				Depth:       3,
+				Metrics:     metrics,
+				Frontier:    frontier,
+			},
+			Path: path,
+			Perm: lab.Perms.File,
+			FS:   fS,
+		})
+		Expect(err).To(Succeed())
+
+		return path
+	}
+
+	load := func(path string) *persist.UnmarshalResult {
+		result, err := persist.Unmarshal(&persist.UnmarshalRequest{
+			Restore: &enclave.RestoreState{
+				Path:     path,
+				FS:       fS,
+				Strategy: enums.ResumeStrategySpawn,
+			},
+		})
+		Expect(err).To(Succeed())
+
+		return result
+	}
+
+	DescribeTable("round trip",
+		func(format enums.PersistenceFormat, name string) {
+			result := load(save(format, name, "/top/a/b/c", "/top/a/d"))
+
+			Expect(result.Format).To(Equal(format))
+			Expect(result.O.Persist.Format).To(Equal(format))
+			Expect(result.O.Behaviours.Cascade.Depth).To(BeEquivalentTo(4))
+			Expect(result.O.Filter.Node.Pattern).To(Equal(flac))
+			Expect(result.Active.CurrentPath).To(Equal("/top/a/b/c"))
+			Expect(result.Active.Hibernation).To(Equal(enums.HibernationPending))
+			Expect(result.Active.Depth).To(BeEquivalentTo(3))
+			Expect(result.Active.Frontier).To(HaveExactElements("/top/a/b/c", "/top/a/d"))
+			Expect(result.Active.Metrics[enums.MetricNoFilesInvoked].Value()).To(
+				BeEquivalentTo(42),
+			)
+			Expect(result.Active.Metrics[enums.MetricNoFileBytesInvoked].Value()).To(
+				Equal(huge),
+			)
+		},
+		func(format enums.PersistenceFormat, name string) string {
+			return "🧪 should: restore state saved as " + format.String() + ": " + name
+		},
+		Entry(nil, enums.PersistJSON, "state.json"),
+		Entry(nil, enums.PersistYAML, "state.yaml"),
+		Entry(nil, enums.PersistGob, "state.gob"),
+		Entry(nil, enums.PersistJSON, "state"),
+		Entry(nil, enums.PersistYAML, "state"),
+		Entry(nil, enums.PersistGob, "state.dat"),
+	)
+
+	When("format is gob", func() {
+		It("🧪 should: be more compact than json for a large frontier", func() {
+			frontier := make([]string, 1000)
+			for i := range frontier {
+				frontier[i] = fmt.Sprintf("/top/artist-%04d/album", i)
+			}
+
+			gob := fS.MapFS[save(enums.PersistGob, "state.gob", frontier...)].Data
+			json := fS.MapFS[save(enums.PersistJSON, "state.json", frontier...)].Data
+
+			Expect(len(gob)).To(BeNumerically("<", len(json)))
+		})
+	})
+
+	When("format is undefined", func() {
+		It("🧪 should: default to json", func() {
+			codec := persist.NewCodec(enums.PersistUndefined)
+
+			Expect(codec.Format()).To(Equal(enums.PersistJSON))
+			Expect(codec.Extension()).To(Equal("json"))
+		})
+	})
+
+	When("content is not identifiable", func() {
+		It("🧪 should: detect by extension", func() {
+			Expect(persist.Detect("state.YML", []byte("")).Format()).To(
+				Equal(enums.PersistYAML),
+			)
+			Expect(persist.Detect("state.json", []byte(strings.Repeat(" ", 3))).Format()).To(
+				Equal(enums.PersistJSON),
+			)
+		})
+	})
+})
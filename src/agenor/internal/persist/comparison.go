@@ -132,6 +132,20 @@ func equalOptions(o *pref.Options, jo *json.Options) error {
 		return err
 	}
 
+	if err := equalFilterDef("toggle-at",
+		o.Hibernate.ToggleAt, jo.Hibernate.ToggleAt,
+	); err != nil {
+		return err
+	}
+
+	if o.Hibernate.Triggers != core.HibernateTriggers(jo.Hibernate.Triggers) {
+		return fmt.Errorf("hibernate-triggers %w", UnequalValueError[core.HibernateTriggers]{
+			Field: "Triggers",
+			Value: o.Hibernate.Triggers,
+			Other: core.HibernateTriggers(jo.Hibernate.Triggers),
+		})
+	}
+
 	if o.Hibernate.Behaviour.InclusiveWake != jo.Hibernate.Behaviour.InclusiveWake {
 		return fmt.Errorf("hibernate-behaviour %w", UnequalValueError[bool]{
 			Field: "InclusiveWake",
@@ -156,6 +170,38 @@ func equalOptions(o *pref.Options, jo *json.Options) error {
 		})
 	}
 
+	if o.Concurrency.ReadAhead != jo.Concurrency.ReadAhead {
+		return fmt.Errorf("concurrency %w", UnequalValueError[uint]{
+			Field: "ReadAhead",
+			Value: o.Concurrency.ReadAhead,
+			Other: jo.Concurrency.ReadAhead,
+		})
+	}
+
+	if o.Throttle.Nodes != jo.Throttle.Nodes {
+		return fmt.Errorf("throttle %w", UnequalValueError[uint]{
+			Field: "Nodes",
+			Value: o.Throttle.Nodes,
+			Other: jo.Throttle.Nodes,
+		})
+	}
+
+	if o.Throttle.Reads != jo.Throttle.Reads {
+		return fmt.Errorf("throttle %w", UnequalValueError[uint]{
+			Field: "Reads",
+			Value: o.Throttle.Reads,
+			Other: jo.Throttle.Reads,
+		})
+	}
+
+	if o.Throttle.Bytes != jo.Throttle.Bytes {
+		return fmt.Errorf("throttle %w", UnequalValueError[uint64]{
+			Field: "Bytes",
+			Value: o.Throttle.Bytes,
+			Other: jo.Throttle.Bytes,
+		})
+	}
+
 	return nil
 }
 
@@ -200,6 +246,30 @@ func equalBehaviours(o *pref.NavigationBehaviours, jo *json.NavigationBehaviours
 		})
 	}
 
+	if o.Cascade.OneFileSystem != jo.Cascade.OneFileSystem {
+		return fmt.Errorf("cascade %w", UnequalValueError[bool]{
+			Field: "OneFileSystem",
+			Value: o.Cascade.OneFileSystem,
+			Other: jo.Cascade.OneFileSystem,
+		})
+	}
+
+	if o.Links.Mode != jo.Links.Mode {
+		return fmt.Errorf("links %w", UnequalValueError[enums.LinkMode]{
+			Field: "Mode",
+			Value: o.Links.Mode,
+			Other: jo.Links.Mode,
+		})
+	}
+
+	if o.Order != jo.Order {
+		return fmt.Errorf("behaviours %w", UnequalValueError[enums.TraversalOrder]{
+			Field: "Order",
+			Value: o.Order,
+			Other: jo.Order,
+		})
+	}
+
 	// sort behaviour??
 
 	return nil
@@ -238,6 +308,22 @@ func equalSamplingOptions(o *pref.SamplingOptions, jo *json.SamplingOptions) err
 		})
 	}
 
+	if o.Seed != jo.Seed {
+		return fmt.Errorf("sampling %w", UnequalValueError[uint64]{
+			Field: "Seed",
+			Value: o.Seed,
+			Other: jo.Seed,
+		})
+	}
+
+	if o.Stride != jo.Stride {
+		return fmt.Errorf("sampling %w", UnequalValueError[uint]{
+			Field: "Stride",
+			Value: o.Stride,
+			Other: jo.Stride,
+		})
+	}
+
 	return nil
 }
 
@@ -254,7 +340,11 @@ func equalFilterOptions(o *pref.FilterOptions, jo *json.FilterOptions) error {
 		return err
 	}
 
-	return nil
+	if err := equalFilterDef("prune", o.Prune, jo.Prune); err != nil {
+		return err
+	}
+
+	return equalNamedFilterDef("named", o.Named, jo.Named)
 }
 
 func equalFilterDef(filterName string,
@@ -354,6 +444,52 @@ func equalFilterDef(filterName string,
 		}
 	}
 
+	return equalCompoundFilterDef(filterName, def.Compound, jdef.Compound)
+}
+
+func equalCompoundFilterDef(filterName string,
+	def *core.CompoundFilterDef, jdef *json.CompoundFilterDef,
+) error {
+	if def == nil && jdef == nil {
+		return nil
+	}
+
+	if def == nil || jdef == nil {
+		return fmt.Errorf("%q compound-filter-def %w", filterName,
+			UnequalPtrError[core.CompoundFilterDef, json.CompoundFilterDef]{
+				Field: "Compound",
+				Value: def,
+				Other: jdef,
+			},
+		)
+	}
+
+	if def.Mode != jdef.Mode {
+		return fmt.Errorf("%q compound-filter-def %w", filterName,
+			UnequalValueError[enums.CompoundMode]{
+				Field: "Mode",
+				Value: def.Mode,
+				Other: jdef.Mode,
+			},
+		)
+	}
+
+	if len(def.Filters) != len(jdef.Filters) {
+		return fmt.Errorf("%q compound-filter-def %w", filterName,
+			UnequalValueError[int]{
+				Field: "len(Filters)",
+				Value: len(def.Filters),
+				Other: len(jdef.Filters),
+			},
+		)
+	}
+
+	for i := range def.Filters {
+		if err := equalFilterDef("compound", &def.Filters[i], &jdef.Filters[i]); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -504,6 +640,16 @@ func equalSampleFilterDef(filterName string,
 		)
 	}
 
+	if (def.Poly == nil) != (jdef.Poly == nil) {
+		return fmt.Errorf("%q sample-filter-def %w", filterName,
+			UnequalPtrError[core.PolyFilterDef, json.PolyFilterDef]{
+				Field: "Poly",
+				Value: def.Poly,
+				Other: jdef.Poly,
+			},
+		)
+	}
+
 	if def.Poly != nil && jdef.Poly != nil {
 		if err := equalFilterDef("poly", &def.Poly.File, &jdef.Poly.File); err != nil {
 			return err
@@ -514,5 +660,45 @@ func equalSampleFilterDef(filterName string,
 		}
 	}
 
+	return equalNamedFilterDef(filterName, def.Named, jdef.Named)
+}
+
+func equalNamedFilterDef(filterName string,
+	def *core.NamedFilterDef, jdef *json.NamedFilterDef,
+) error {
+	if def == nil && jdef == nil {
+		return nil
+	}
+
+	if def == nil || jdef == nil {
+		return fmt.Errorf("%q named-filter-def %w", filterName,
+			UnequalPtrError[core.NamedFilterDef, json.NamedFilterDef]{
+				Field: "Named",
+				Value: def,
+				Other: jdef,
+			},
+		)
+	}
+
+	if def.Name != jdef.Name {
+		return fmt.Errorf("%q named-filter-def %w", filterName,
+			UnequalValueError[string]{
+				Field: "Name",
+				Value: def.Name,
+				Other: jdef.Name,
+			},
+		)
+	}
+
+	if def.Params != jdef.Params {
+		return fmt.Errorf("%q named-filter-def %w", filterName,
+			UnequalValueError[string]{
+				Field: "Params",
+				Value: def.Params,
+				Other: jdef.Params,
+			},
+		)
+	}
+
 	return nil
 }
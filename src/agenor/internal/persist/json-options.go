@@ -30,9 +30,14 @@ func ToJSON(o *pref.Options) *json.Options {
 				SortFilesFirst:  o.Behaviours.Sort.SortFilesFirst,
 			},
 			Cascade: json.CascadeBehaviour{
-				Depth:     o.Behaviours.Cascade.Depth,
-				NoRecurse: o.Behaviours.Cascade.NoRecurse,
+				Depth:         o.Behaviours.Cascade.Depth,
+				NoRecurse:     o.Behaviours.Cascade.NoRecurse,
+				OneFileSystem: o.Behaviours.Cascade.OneFileSystem,
 			},
+			Links: json.LinkBehaviour{
+				Mode: o.Behaviours.Links.Mode,
+			},
+			Order: o.Behaviours.Order,
 		},
 		Sampling: json.SamplingOptions{
 			Type:      o.Sampling.Type,
@@ -41,6 +46,8 @@ func ToJSON(o *pref.Options) *json.Options {
 				Files:       o.Sampling.NoOf.Files,
 				Directories: o.Sampling.NoOf.Directories,
 			},
+			Seed:   o.Sampling.Seed,
+			Stride: o.Sampling.Stride,
 		},
 		Filter: json.FilterOptions{
 			Node: NodeFilterDefToJSON(o.Filter.Node),
@@ -65,23 +72,35 @@ func ToJSON(o *pref.Options) *json.Options {
 						Pattern:     o.Filter.Sample.Pattern,
 						Scope:       o.Filter.Sample.Scope,
 						Negate:      o.Filter.Sample.Negate,
+						Poly:        NodePolyDefToJSON(o.Filter.Sample.Poly),
+						Named:       NamedFilterDefToJSON(o.Filter.Sample.Named),
 					}
 				},
 				func() *json.SampleFilterDef {
 					return nil
 				},
 			),
+			Prune: NodeFilterDefToJSON(o.Filter.Prune),
+			Named: NamedFilterDefToJSON(o.Filter.Named),
 		},
 		Hibernate: json.HibernateOptions{
-			WakeAt:  NodeFilterDefToJSON(o.Hibernate.WakeAt),
-			SleepAt: NodeFilterDefToJSON(o.Hibernate.SleepAt),
+			WakeAt:   NodeFilterDefToJSON(o.Hibernate.WakeAt),
+			SleepAt:  NodeFilterDefToJSON(o.Hibernate.SleepAt),
+			ToggleAt: NodeFilterDefToJSON(o.Hibernate.ToggleAt),
+			Triggers: json.HibernateTriggers(o.Hibernate.Triggers),
 			Behaviour: json.HibernationBehaviour{
 				InclusiveWake:  o.Hibernate.Behaviour.InclusiveWake,
 				InclusiveSleep: o.Hibernate.Behaviour.InclusiveSleep,
 			},
 		},
 		Concurrency: json.ConcurrencyOptions{
-			NoW: o.Concurrency.NoW,
+			NoW:       o.Concurrency.NoW,
+			ReadAhead: o.Concurrency.ReadAhead,
+		},
+		Throttle: json.ThrottleOptions{
+			Nodes: o.Throttle.Nodes,
+			Reads: o.Throttle.Reads,
+			Bytes: o.Throttle.Bytes,
 		},
 	}
 }
@@ -100,6 +119,7 @@ func NodeFilterDefToJSON(def *core.FilterDef) *json.FilterDef {
 				Scope:           def.Scope,
 				IfNotApplicable: def.IfNotApplicable,
 				Poly:            NodePolyDefToJSON(def.Poly),
+				Compound:        NodeCompoundDefToJSON(def.Compound),
 			}
 		},
 		func() *json.FilterDef { return nil },
@@ -118,6 +138,34 @@ func NodePolyDefToJSON(poly *core.PolyFilterDef) *json.PolyFilterDef {
 	}
 }
 
+// NodeCompoundDefToJSON converts a core.CompoundFilterDef to a
+// json.CompoundFilterDef.
+func NodeCompoundDefToJSON(compound *core.CompoundFilterDef) *json.CompoundFilterDef {
+	if compound == nil {
+		return nil
+	}
+
+	return &json.CompoundFilterDef{
+		Mode: compound.Mode,
+		Filters: lo.Map(compound.Filters, func(def core.FilterDef, _ int) json.FilterDef {
+			return *NodeFilterDefToJSON(&def)
+		}),
+	}
+}
+
+// NamedFilterDefToJSON converts a core.NamedFilterDef to a
+// json.NamedFilterDef.
+func NamedFilterDefToJSON(named *core.NamedFilterDef) *json.NamedFilterDef {
+	if named == nil {
+		return nil
+	}
+
+	return &json.NamedFilterDef{
+		Name:   named.Name,
+		Params: named.Params,
+	}
+}
+
 // FromJSON converts a json.Options struct to a pref.Options struct.
 func FromJSON(jo *json.Options) *pref.Options {
 	o := pref.DefaultOptions()
@@ -131,9 +179,14 @@ func FromJSON(jo *json.Options) *pref.Options {
 			SortFilesFirst:  jo.Behaviours.Sort.SortFilesFirst,
 		},
 		Cascade: pref.CascadeBehaviour{
-			Depth:     jo.Behaviours.Cascade.Depth,
-			NoRecurse: jo.Behaviours.Cascade.NoRecurse,
+			Depth:         jo.Behaviours.Cascade.Depth,
+			NoRecurse:     jo.Behaviours.Cascade.NoRecurse,
+			OneFileSystem: jo.Behaviours.Cascade.OneFileSystem,
 		},
+		Links: pref.LinkBehaviour{
+			Mode: jo.Behaviours.Links.Mode,
+		},
+		Order: jo.Behaviours.Order,
 	}
 	o.Sampling = pref.SamplingOptions{
 		Type:      jo.Sampling.Type,
@@ -142,6 +195,8 @@ func FromJSON(jo *json.Options) *pref.Options {
 			Files:       jo.Sampling.NoOf.Files,
 			Directories: jo.Sampling.NoOf.Directories,
 		},
+		Seed:   jo.Sampling.Seed,
+		Stride: jo.Sampling.Stride,
 	}
 	o.Filter = pref.FilterOptions{
 		Node: NodeFilterDefFromJSON(jo.Filter.Node),
@@ -166,24 +221,35 @@ func FromJSON(jo *json.Options) *pref.Options {
 					Pattern:     jo.Filter.Sample.Pattern,
 					Scope:       jo.Filter.Sample.Scope,
 					Negate:      jo.Filter.Sample.Negate,
-					// TODO:Poly: tbd,
+					Poly:        NodePolyDefFromJSON(jo.Filter.Sample.Poly),
+					Named:       NamedFilterDefFromJSON(jo.Filter.Sample.Named),
 				}
 			},
 			func() *core.SampleFilterDef {
 				return nil
 			},
 		),
+		Prune: NodeFilterDefFromJSON(jo.Filter.Prune),
+		Named: NamedFilterDefFromJSON(jo.Filter.Named),
 	}
 	o.Hibernate = core.HibernateOptions{
-		WakeAt:  NodeFilterDefFromJSON(jo.Hibernate.WakeAt),
-		SleepAt: NodeFilterDefFromJSON(jo.Hibernate.SleepAt),
+		WakeAt:   NodeFilterDefFromJSON(jo.Hibernate.WakeAt),
+		SleepAt:  NodeFilterDefFromJSON(jo.Hibernate.SleepAt),
+		ToggleAt: NodeFilterDefFromJSON(jo.Hibernate.ToggleAt),
+		Triggers: core.HibernateTriggers(jo.Hibernate.Triggers),
 		Behaviour: core.HibernationBehaviour{
 			InclusiveWake:  jo.Hibernate.Behaviour.InclusiveWake,
 			InclusiveSleep: jo.Hibernate.Behaviour.InclusiveSleep,
 		},
 	}
 	o.Concurrency = pref.ConcurrencyOptions{
-		NoW: jo.Concurrency.NoW,
+		NoW:       jo.Concurrency.NoW,
+		ReadAhead: jo.Concurrency.ReadAhead,
+	}
+	o.Throttle = pref.ThrottleOptions{
+		Nodes: jo.Throttle.Nodes,
+		Reads: jo.Throttle.Reads,
+		Bytes: jo.Throttle.Bytes,
 	}
 
 	return o
@@ -201,6 +267,7 @@ func NodeFilterDefFromJSON(def *json.FilterDef) *core.FilterDef {
 				Scope:           def.Scope,
 				IfNotApplicable: def.IfNotApplicable,
 				Poly:            NodePolyDefFromJSON(def.Poly),
+				Compound:        NodeCompoundDefFromJSON(def.Compound),
 			}
 		},
 		func() *core.FilterDef {
@@ -220,3 +287,31 @@ func NodePolyDefFromJSON(poly *json.PolyFilterDef) *core.PolyFilterDef {
 		Directory: *NodeFilterDefFromJSON(&poly.Directory),
 	}
 }
+
+// NodeCompoundDefFromJSON converts a json.CompoundFilterDef to a
+// core.CompoundFilterDef.
+func NodeCompoundDefFromJSON(compound *json.CompoundFilterDef) *core.CompoundFilterDef {
+	if compound == nil {
+		return nil
+	}
+
+	return &core.CompoundFilterDef{
+		Mode: compound.Mode,
+		Filters: lo.Map(compound.Filters, func(def json.FilterDef, _ int) core.FilterDef {
+			return *NodeFilterDefFromJSON(&def)
+		}),
+	}
+}
+
+// NamedFilterDefFromJSON converts a json.NamedFilterDef to a
+// core.NamedFilterDef.
+func NamedFilterDefFromJSON(named *json.NamedFilterDef) *core.NamedFilterDef {
+	if named == nil {
+		return nil
+	}
+
+	return &core.NamedFilterDef{
+		Name:   named.Name,
+		Params: named.Params,
+	}
+}
@@ -127,6 +127,9 @@ var _ = Describe("Marshaler", Ordered, func() {
 		jsonPolyNodeFilterDef json.FilterDef
 		polyNodeFilterDef     *core.FilterDef
 
+		jsonCompoundNodeFilterDef json.FilterDef
+		compoundNodeFilterDef     *core.FilterDef
+
 		// 🍑 CHILD:
 		//
 		sourceChildFilterDef *core.ChildFilterDef
@@ -185,6 +188,24 @@ var _ = Describe("Marshaler", Ordered, func() {
 			},
 		)
 
+		compoundNodeFilterDef = &core.FilterDef{
+			Type:        enums.FilterTypeCompound,
+			Description: "flac items that are not hidden",
+			Scope:       enums.ScopeFile,
+			Compound: &core.CompoundFilterDef{
+				Mode: enums.CompoundModeAll,
+				Filters: []core.FilterDef{
+					*sourceNodeFilterDef,
+					{
+						Type:    enums.FilterTypeRegex,
+						Pattern: `^\._`,
+						Negate:  true,
+					},
+				},
+			},
+		}
+		jsonCompoundNodeFilterDef = *persist.NodeFilterDefToJSON(compoundNodeFilterDef)
+
 		// 🍑 CHILD:
 		//
 		sourceChildFilterDef = createChildFromNode(sourceNodeFilterDef)
@@ -426,6 +447,158 @@ var _ = Describe("Marshaler", Ordered, func() {
 				},
 			}),
 
+			// 🍉 FilterOptions.Node.Compound
+			//
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions.Node.Compound - nil:json.Options",
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Node: compoundNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Node = &jsonCompoundNodeFilterDef
+					result.JO.Filter.Node.Compound = nil
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions - Node.Compound.Mode",
+				},
+				checkerTE: &checkerTE{
+					field:   "Mode",
+					checker: check[enums.CompoundMode],
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Node: compoundNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Node = &jsonCompoundNodeFilterDef
+					result.JO.Filter.Node.Compound.Mode = enums.CompoundModeAny
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions - Node.Compound.Filters",
+				},
+				checkerTE: &checkerTE{
+					field:   "len(Filters)",
+					checker: check[int],
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Node: compoundNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Node = &jsonCompoundNodeFilterDef
+					result.JO.Filter.Node.Compound.Filters = result.JO.Filter.Node.Compound.Filters[:1]
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions - Node.Compound.Filters[1]",
+				},
+				checkerTE: &checkerTE{
+					field:   "Negate",
+					checker: check[bool],
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Node: compoundNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Node = &jsonCompoundNodeFilterDef
+					result.JO.Filter.Node.Compound.Filters[1].Negate = false
+				},
+			}),
+
+			// 🍉 FilterOptions.Prune
+			//
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions.Prune - nil:json.Options",
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Prune: sourceNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Prune = nil
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions - Prune.Pattern",
+				},
+				checkerTE: &checkerTE{
+					field:   "Pattern",
+					checker: check[string],
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Prune: sourceNodeFilterDef,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Prune = &jsonNodeFilterDef
+					result.JO.Filter.Prune.Pattern = bar
+				},
+			}),
+
+			// 🍉 FilterOptions.Named
+			//
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions.Named - nil:json.Options",
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Named: &core.NamedFilterDef{
+							Name:   foo,
+							Params: flac,
+						},
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Named = nil
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "FilterOptions - Named.Params",
+				},
+				checkerTE: &checkerTE{
+					field:   "Params",
+					checker: check[string],
+				},
+				option: func() pref.Option {
+					return pref.WithFilter(&pref.FilterOptions{
+						Named: &core.NamedFilterDef{
+							Name:   foo,
+							Params: flac,
+						},
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Filter.Named = &json.NamedFilterDef{
+						Name:   foo,
+						Params: bar,
+					}
+				},
+			}),
+
 			// 🍉 FilterOptions.Child
 			//
 			Entry(nil, &marshalTE{
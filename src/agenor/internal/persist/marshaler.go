@@ -1,10 +1,10 @@
 package persist
 
 import (
-	ejson "encoding/json"
 	"io/fs"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	json "github.com/snivilised/jaywalk/src/agenor/internal/opts/jason"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
@@ -64,6 +64,9 @@ type (
 
 		// O is the options that were unmarshaled
 		O *pref.Options
+
+		// Format is the format that was detected
+		Format enums.PersistenceFormat
 	}
 
 	// Comparison is a struct used to compare the JSON options and the pref options
@@ -84,10 +87,7 @@ func Marshal(request *MarshalRequest) (*MarshalResult, error) {
 		Active: request.Active.Clone(),
 	}
 
-	data, err := ejson.MarshalIndent(
-		result,
-		JSONMarshalNoPrefix, JSONMarshal2SpacesIndent,
-	)
+	data, err := NewCodec(request.O.Persist.Format).Encode(result)
 	if err != nil {
 		return nil, err
 	}
@@ -106,16 +106,17 @@ func Marshal(request *MarshalRequest) (*MarshalResult, error) {
 func Unmarshal(request *UnmarshalRequest,
 	tampers ...TamperFunc,
 ) (*UnmarshalResult, error) {
-	bytes, err := request.Restore.FS.ReadFile(request.Restore.Path)
+	data, err := request.Restore.FS.ReadFile(request.Restore.Path)
 	if err != nil {
 		return nil, err
 	}
 
 	var (
-		mr MarshalResult
+		mr    MarshalResult
+		codec = Detect(request.Restore.Path, data)
 	)
 
-	if err := ejson.Unmarshal(bytes, &mr); err != nil {
+	if err := codec.Decode(data, &mr); err != nil {
 		return nil, err
 	}
 
@@ -127,8 +128,14 @@ func Unmarshal(request *UnmarshalRequest,
 		O:      FromJSON(mr.JO),
 		Active: mr.Active,
 		JO:     mr.JO,
+		Format: codec.Format(),
 	}
 
+	// a resumed navigation saves its state in the same format, unless
+	// requested otherwise
+	//
+	result.O.Persist.Format = codec.Format()
+
 	return &result, (&Comparison{
 		O:  result.O,
 		JO: result.JO,
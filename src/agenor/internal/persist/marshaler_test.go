@@ -122,6 +122,8 @@ func createJSONSamplingOptions(so *pref.SamplingOptions) *json.SamplingOptions {
 			Files:       so.NoOf.Files,
 			Directories: so.NoOf.Directories,
 		},
+		Seed:   so.Seed,
+		Stride: so.Stride,
 	}
 }
 
@@ -174,6 +176,8 @@ var _ = Describe("Marshaler", Ordered, func() {
 				Files:       2,
 				Directories: 3,
 			},
+			Seed:   42,
+			Stride: 3,
 		}
 
 		jsonSamplingOptions = createJSONSamplingOptions(samplingOptions)
@@ -282,6 +286,52 @@ var _ = Describe("Marshaler", Ordered, func() {
 				},
 			}),
 
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "NavigationBehaviours.CascadeBehaviour.OneFileSystem",
+				},
+				checkerTE: &checkerTE{
+					field:   "OneFileSystem",
+					checker: check[bool],
+				},
+				option: pref.WithOneFileSystem,
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Behaviours.Cascade.OneFileSystem = false
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "NavigationBehaviours.LinkBehaviour.Mode",
+				},
+				checkerTE: &checkerTE{
+					field:   "Mode",
+					checker: check[enums.LinkMode],
+				},
+				option: func() pref.Option {
+					return pref.WithLinkBehaviour(&pref.LinkBehaviour{
+						Mode: enums.LinkModeFollow,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Behaviours.Links.Mode = enums.LinkModeIgnore
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "NavigationBehaviours.Order",
+				},
+				checkerTE: &checkerTE{
+					field:   "Order",
+					checker: check[enums.TraversalOrder],
+				},
+				option: pref.WithBreadthFirst,
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Behaviours.Order = enums.TraversalOrderDepthFirst
+				},
+			}),
+
 			// 🍉 SamplingOptions:
 			//
 			Entry(nil, &marshalTE{
@@ -348,6 +398,40 @@ var _ = Describe("Marshaler", Ordered, func() {
 				},
 			}),
 
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "NavigationBehaviours.SamplingOptions.Seed",
+				},
+				checkerTE: &checkerTE{
+					field:   "Seed",
+					checker: check[uint64],
+				},
+				option: func() pref.Option {
+					return pref.WithSamplingOptions(samplingOptions)
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Sampling = *jsonSamplingOptions
+					result.JO.Sampling.Seed = 99
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "NavigationBehaviours.SamplingOptions.Stride",
+				},
+				checkerTE: &checkerTE{
+					field:   "Stride",
+					checker: check[uint],
+				},
+				option: func() pref.Option {
+					return pref.WithSamplingOptions(samplingOptions)
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Sampling = *jsonSamplingOptions
+					result.JO.Sampling.Stride = 99
+				},
+			}),
+
 			// 🍉 HibernateOptions:
 			//
 			Entry(nil, &marshalTE{
@@ -412,6 +496,25 @@ var _ = Describe("Marshaler", Ordered, func() {
 				},
 			}),
 
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "HibernateOptions.Triggers",
+				},
+				checkerTE: &checkerTE{
+					field:   "Triggers",
+					checker: check[core.HibernateTriggers],
+				},
+				option: func() pref.Option {
+					return pref.WithHibernationTriggers(&core.HibernateTriggers{
+						WakeAfterNodes:    10,
+						SleepAfterInvokes: 5,
+					})
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Hibernate.Triggers.SleepAfterInvokes = 6
+				},
+			}),
+
 			// 🍉 ConcurrencyOptions:
 			//
 			Entry(nil, &marshalTE{
@@ -429,8 +532,134 @@ var _ = Describe("Marshaler", Ordered, func() {
 					result.JO.Concurrency.NoW = 99
 				},
 			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "ConcurrencyOptions.ReadAhead",
+				},
+				checkerTE: &checkerTE{
+					field:   "ReadAhead",
+					checker: check[uint],
+				},
+				option: func() pref.Option {
+					return func(o *pref.Options) error {
+						// NoW is compared before ReadAhead, so it must match
+						// the value in the restore file.
+						o.Concurrency.NoW = 8
+						o.Concurrency.ReadAhead = 4
+
+						return nil
+					}
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Concurrency.ReadAhead = 99
+				},
+			}),
+
+			// 🍉 ThrottleOptions:
+			//
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "ThrottleOptions.Nodes",
+				},
+				checkerTE: &checkerTE{
+					field:   "Nodes",
+					checker: check[uint],
+				},
+				option: func() pref.Option {
+					return func(o *pref.Options) error {
+						// NoW is compared before Throttle, so it must match
+						// the value in the restore file.
+						o.Concurrency.NoW = 8
+						o.Throttle.Nodes = 10
+
+						return nil
+					}
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Throttle.Nodes = 99
+				},
+			}),
+
+			Entry(nil, &marshalTE{
+				persistTE: persistTE{
+					given: "ThrottleOptions.Bytes",
+				},
+				checkerTE: &checkerTE{
+					field:   "Bytes",
+					checker: check[uint64],
+				},
+				option: func() pref.Option {
+					return func(o *pref.Options) error {
+						o.Concurrency.NoW = 8
+						o.Throttle.Bytes = 1024
+
+						return nil
+					}
+				},
+				tweak: func(result *persist.MarshalResult) {
+					result.JO.Throttle.Bytes = 99
+				},
+			}),
 		)
 
+		Context("round trip", func() {
+			When("sample filter is poly", func() {
+				It("🧪 should: restore the poly constituents", func() {
+					sample := &core.SampleFilterDef{
+						Type:        enums.FilterTypePoly,
+						Description: "flac files in directories starting with D",
+						Poly: &core.PolyFilterDef{
+							File: core.FilterDef{
+								Type:        enums.FilterTypeGlobEx,
+								Description: "glob-ex: items with .flac suffix",
+								Pattern:     "*|*.flac",
+								Scope:       enums.ScopeFile,
+							},
+							Directory: core.FilterDef{
+								Type:        enums.FilterTypeGlob,
+								Description: "glob: directories starting with D",
+								Pattern:     "D*",
+								Scope:       enums.ScopeDirectory,
+								Negate:      true,
+							},
+						},
+					}
+					o, _, err := opts.Get(
+						pref.WithSamplingOptions(samplingOptions),
+						pref.WithFilter(&pref.FilterOptions{
+							Sample: sample,
+						}),
+					)
+					Expect(err).To(Succeed())
+
+					path := destination + "/" + tempFile
+					_, err = persist.Marshal(&persist.MarshalRequest{
+						O: o,
+						Active: &core.ActiveState{
+							Tree:        destination,
+							CurrentPath: "/top/a/b/c",
+						},
+						Path: path,
+						Perm: lab.Perms.File,
+						FS:   fS,
+					})
+					Expect(err).To(Succeed())
+
+					state, err := persist.Unmarshal(&persist.UnmarshalRequest{
+						Restore: &enclave.RestoreState{
+							Path:     path,
+							FS:       fS,
+							Strategy: enums.ResumeStrategySpawn,
+						},
+					})
+					Expect(err).To(Succeed())
+					Expect(state.O.Filter.Sample).NotTo(BeNil())
+					Expect(state.O.Filter.Sample.Poly).To(Equal(sample.Poly))
+				})
+			})
+		})
+
 		Context("UnequalPtrError", func() {
 			When("pref.Options is nil", func() {
 				It("🧪 should: return UnequalPtrError", func() {
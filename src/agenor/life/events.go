@@ -30,6 +30,11 @@ type (
 		// be used by the handler to provide context about the traversal.
 		Begin Event[BeginHandler]
 
+		// Cycle is invoked when a followed symbolic link resolves to one of its own
+		// ancestor directories. The handler function takes a Node as a parameter, which
+		// represents the link that is not followed.
+		Cycle Event[NodeHandler]
+
 		// Descend is invoked after descending a directory. The handler function takes a Node
 		// as a parameter, which represents the directory being descended.
 		Descend Event[NodeHandler]
@@ -62,6 +67,9 @@ type (
 		// Begin is the notification controller for the Begin event.
 		Begin NotificationCtrl[BeginHandler]
 
+		// Cycle is the notification controller for the Cycle event.
+		Cycle NotificationCtrl[NodeHandler]
+
 		// Descend is the notification controller for the Descend event.
 		Descend NotificationCtrl[NodeHandler]
 
@@ -95,6 +103,7 @@ func NewControls() *Controls {
 	return &Controls{
 		Ascend:  *NewNotificationCtrl[NodeHandler](nopNode, broadcastNode),
 		Begin:   *NewNotificationCtrl[BeginHandler](nopBegin, broadcastBegin),
+		Cycle:   *NewNotificationCtrl[NodeHandler](nopNode, broadcastNode),
 		Descend: *NewNotificationCtrl[NodeHandler](nopNode, broadcastNode),
 		End:     *NewNotificationCtrl[EndHandler](nopEnd, broadcastEnd),
 		Wake:    *NewNotificationCtrl[HibernateHandler](nopHibernate, broadcastHibernate),
@@ -107,6 +116,7 @@ func NewControls() *Controls {
 func (c *Controls) MuteAll() {
 	c.Ascend.Mute()
 	c.Begin.Mute()
+	c.Cycle.Mute()
 	c.Descend.Mute()
 	c.End.Mute()
 	c.Wake.Mute()
@@ -120,6 +130,7 @@ func (c *Controls) MuteAll() {
 func (c *Controls) UnmuteAll() {
 	c.Ascend.Unmute()
 	c.Begin.Unmute()
+	c.Cycle.Unmute()
 	c.Descend.Unmute()
 	c.End.Unmute()
 	c.Sleep.Unmute()
@@ -130,12 +141,25 @@ func (c *Controls) UnmuteAll() {
 func (e *Events) Bind(cs *Controls) {
 	e.Ascend = &cs.Ascend
 	e.Begin = &cs.Begin
+	e.Cycle = &cs.Cycle
 	e.Descend = &cs.Descend
 	e.End = &cs.End
 	e.Wake = &cs.Wake
 	e.Sleep = &cs.Sleep
 }
 
+// OffAll unsubscribes all handlers from all the life cycle events, so that
+// none of them are invoked.
+func (e *Events) OffAll() {
+	e.Ascend.Off()
+	e.Begin.Off()
+	e.Cycle.Off()
+	e.Descend.Off()
+	e.End.Off()
+	e.Wake.Off()
+	e.Sleep.Off()
+}
+
 // On subscribes to a life cycle event
 func (c *NotificationCtrl[F]) On(handler F) {
 	if !c.subscribed {
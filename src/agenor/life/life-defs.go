@@ -17,6 +17,9 @@ type (
 	Event[F any] interface {
 		// On subscribes to a life cycle event
 		On(handler F)
+
+		// Off unsubscribes all handlers from a life cycle event
+		Off()
 	}
 
 	// SimpleHandler is a function that takes no extra custom parameters and can
@@ -77,8 +77,20 @@ type (
 		// traverse session is loaded.
 		From string
 
+		// Latest selects the most recently saved resumption file for the
+		// tree denoted by Latest, as an alternative to specifying From.
+		Latest string
+
+		// Admin is the admin path searched for the Latest resumption file;
+		// defaults to the default admin path.
+		Admin string
+
 		// Strategy represent what type of resume is run.
 		Strategy enums.ResumeStrategy
+
+		// Drift determines how the resume responds to the tree having
+		// changed since the navigation state was saved.
+		Drift enums.DriftPolicy
 	}
 )
 
@@ -188,7 +200,7 @@ func (f *Using) Magnitude() string {
 // Validate checks that the required properties of the Relic are set and returns
 // an error if not.
 func (f *Relic) Validate() error {
-	if f.From == "" {
+	if f.From == "" && f.Latest == "" {
 		return locale.ErrUsageMissingRestorePath
 	}
 
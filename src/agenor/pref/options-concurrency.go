@@ -60,6 +60,13 @@ type (
 
 		// Output contains output properties
 		Output OutputOptions
+
+		// ReadAhead specifies the number of go-routines used to prefetch the
+		// contents of the sub-directories of the directory currently being
+		// navigated. Prefetching does not affect the order in which nodes are
+		// delivered to the client. A value of 0 (the default) disables
+		// read ahead, so each directory is read only when it is navigated.
+		ReadAhead uint
 	}
 )
 
@@ -93,3 +100,16 @@ func WithOutput(output *OutputOptions) Option {
 		return nil
 	}
 }
+
+// WithReadAhead requests that the contents of sub-directories are prefetched
+// on n go-routines via the ReadDirectory hook, while the current directory is
+// being navigated. This is of benefit on file systems where reading a directory
+// is slow, eg network mounted or spinning disks. The order in which nodes are
+// delivered to the client remains the same as it would without read ahead.
+func WithReadAhead(n uint) Option {
+	return func(o *Options) error {
+		o.Concurrency.ReadAhead = n
+
+		return nil
+	}
+}
@@ -30,7 +30,7 @@ type (
 	// specified by the client. These options are used to determine which file
 	// system nodes (files or directories) the client defined handler is invoked
 	// for. Note that the filter does not determine navigation, it only determines
-	// wether the callback is invoked.
+	// wether the callback is invoked; the exception being Prune.
 	FilterOptions struct {
 		// Node filter definitions that applies to the current file system node
 		//
@@ -45,21 +45,37 @@ type (
 		//
 		Sample *core.SampleFilterDef
 
+		// Prune is the filter that determines which directories are not
+		// descended; a directory that matches is neither navigated nor
+		// invoked for. This avoids the cost of reading large sub-trees
+		// that are of no interest, eg node_modules or .git. Since the
+		// filter is applied before the directory is read, a scope
+		// that depends on the directory's contents, ie ScopeLeaf, does
+		// not apply. The tree itself is never pruned.
+		//
+		Prune *core.FilterDef
+
 		// Sink allows client access to the filter that is derived from the
 		// filter definition
 		//
 		Sink FilteringSink
 
 		// Custom client define-able filter. When restoring for resume feature,
-		// its the client's responsibility to restore this themselves (see
-		// PersistenceRestorer)
+		// it is re-created from Named, otherwise its the client's
+		// responsibility to restore this themselves (see PersistenceRestorer)
 		Custom core.TraverseFilter
+
+		// Named identifies the custom filter, so that it can be re-created
+		// on resume. When Custom is not set, the filter is created from the
+		// registered factory.
+		Named *core.NamedFilterDef
 	}
 )
 
 // WithFilter used to determine which file system nodes (files or directories)
 // the client defined handler is invoked for. Note that the filter does not
-// determine navigation, it only determines wether the callback is invoked.
+// determine navigation, it only determines wether the callback is invoked,
+// unless a Prune filter is defined.
 func WithFilter(filter *FilterOptions) Option {
 	return func(o *Options) error {
 		o.Filter = *filter
@@ -72,7 +88,7 @@ func WithFilter(filter *FilterOptions) Option {
 // contain a node filter definition.
 func (fo FilterOptions) IsNodeFilteringActive() bool {
 	return (fo.Node != nil) &&
-		((fo.Node.Pattern != "") || fo.Node.Poly != nil)
+		((fo.Node.Pattern != "") || fo.Node.Poly != nil || fo.Node.Compound != nil)
 }
 
 // IsChildFilteringActive returns true if the filter options
@@ -95,8 +111,14 @@ func (fo FilterOptions) IsFilteringActive() bool {
 		fo.IsCustomFilteringActive()
 }
 
+// IsPruningActive returns true if the filter options contain a
+// prune filter definition.
+func (fo FilterOptions) IsPruningActive() bool {
+	return fo.Prune != nil
+}
+
 // IsCustomFilteringActive returns true if the filter options contain
-// a custom filter.
+// a custom or named filter.
 func (fo FilterOptions) IsCustomFilteringActive() bool {
-	return fo.Custom != nil
+	return fo.Custom != nil || fo.Named != nil
 }
@@ -45,6 +45,27 @@ func WithHibernationFilterSleep(sleep *core.FilterDef) Option {
 	}
 }
 
+// WithHibernationFilterToggle defines the toggle condition for
+// hibernation based traversal sessions, where each matching node
+// flips hibernation between dormant and active.
+func WithHibernationFilterToggle(toggle *core.FilterDef) Option {
+	return func(o *Options) error {
+		o.Hibernate.ToggleAt = toggle
+
+		return nil
+	}
+}
+
+// WithHibernationTriggers defines wake and sleep conditions based on
+// counts, depth and time, which compose with the wake and sleep filters.
+func WithHibernationTriggers(triggers *core.HibernateTriggers) Option {
+	return func(o *Options) error {
+		o.Hibernate.Triggers = *triggers
+
+		return nil
+	}
+}
+
 // WithHibernationOptions defines options for a hibernation traversal
 // session.
 func WithHibernationOptions(ho *core.HibernateOptions) Option {
@@ -25,6 +25,17 @@ func WithOnBegin(handler life.BeginHandler) Option {
 	}
 }
 
+// WithOnCycle sets the cycle handler, invoked when a followed symbolic
+// link resolves to one of its own ancestor directories. The link is
+// delivered as a non directory node and is not navigated.
+func WithOnCycle(handler life.NodeHandler) Option {
+	return func(o *Options) error {
+		o.Events.Cycle.On(handler)
+
+		return nil
+	}
+}
+
 // WithOnDescend sets the descend handler, invoked when navigator
 // traverses down into a child directory.
 func WithOnDescend(handler life.NodeHandler) Option {
@@ -2,6 +2,7 @@ package pref
 
 import (
 	"log/slog"
+	"time"
 )
 
 type (
@@ -11,6 +12,26 @@ type (
 		Path string
 	}
 
+	// CheckpointOptions defines options for the periodic saving of the
+	// navigation state, so that navigation can be resumed, even when the
+	// process is terminated without the opportunity to rescue a panic, eg
+	// when it is killed or the machine reboots.
+	CheckpointOptions struct {
+		// Nodes specifies the number of nodes invoked, after which a checkpoint
+		// is written. A value of 0 means checkpoints are not written on the
+		// basis of node count.
+		Nodes uint
+
+		// Interval specifies the minimum duration that elapses between successive
+		// checkpoints. A value of 0 means checkpoints are not written on the
+		// basis of time.
+		Interval time.Duration
+
+		// Keep specifies the number of checkpoint files retained, the oldest
+		// being removed once exceeded. At least one is always retained.
+		Keep uint
+	}
+
 	// MonitorOptions represents the options for monitoring the traversal process.
 	MonitorOptions struct {
 		// Log is the logger used for logging messages during the traversal process.
@@ -18,9 +39,31 @@ type (
 
 		// Admin specifies the options for admin related configurations.
 		Admin AdminOptions
+
+		// Instrument enables the recording of the durations of directory reads,
+		// status queries and client callbacks, which are aggregated into
+		// histograms available from the TraverseResult (see Timings). Disabled
+		// by default, as it incurs the cost of querying the time for every
+		// operation measured.
+		Instrument bool
+
+		// Checkpoint specifies when the navigation state is saved in the background.
+		// Checkpoint files are written to the admin path and are removed when the
+		// navigation completes successfully.
+		Checkpoint CheckpointOptions
+
+		// SaveOnInterrupt requests that the navigation is cancelled on receipt of
+		// an interrupt (SIGINT) or termination (SIGTERM) signal, which results in
+		// the navigation state being saved, so that it can be resumed.
+		SaveOnInterrupt bool
 	}
 )
 
+// IsActive determines whether periodic checkpointing has been requested
+func (co *CheckpointOptions) IsActive() bool {
+	return co.Nodes > 0 || co.Interval > 0
+}
+
 // WithAdminPath defines the path for admin related files
 func WithAdminPath(path string) Option {
 	return func(o *Options) error {
@@ -38,3 +81,37 @@ func WithLogger(logger *slog.Logger) Option {
 		return nil
 	}
 }
+
+// WithInstrumentation enables the recording of the durations of directory
+// reads, status queries and client callbacks.
+func WithInstrumentation() Option {
+	return func(o *Options) error {
+		o.Monitor.Instrument = true
+
+		return nil
+	}
+}
+
+// WithCheckpoint requests that the navigation state is saved in the
+// background, every so many nodes and/or every so often, so that
+// navigation can be resumed from near the point at which it was
+// terminated, if the process is unable to rescue a panic.
+func WithCheckpoint(co *CheckpointOptions) Option {
+	return func(o *Options) error {
+		o.Monitor.Checkpoint = *co
+
+		return nil
+	}
+}
+
+// WithInterruptSave requests that the navigation state is saved when the
+// process is interrupted (ctrl-c) or terminated, so that the navigation
+// can be resumed. The cancellation of the context passed to Navigate
+// always results in the state being saved.
+func WithInterruptSave() Option {
+	return func(o *Options) error {
+		o.Monitor.SaveOnInterrupt = true
+
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package pref
 
 import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 )
 
 type (
@@ -59,6 +60,22 @@ type (
 		// only the files in a specified directory.
 		//
 		NoRecurse bool
+
+		// OneFileSystem prevents the navigator from descending into directories
+		// that reside on a different device to the tree, akin to find -xdev.
+		// Mount points are neither descended nor delivered to the client. This
+		// requires the file system to provide the native status of its entries
+		// (as the local file system does) and has no effect otherwise.
+		//
+		OneFileSystem bool
+	}
+
+	// LinkBehaviour behaviours relating to the handling of symbolic links
+	LinkBehaviour struct {
+		// Mode defines whether links are ignored, reported or followed. When
+		// following links, a link that resolves to one of its own ancestor
+		// directories is not followed (see the Cycle life event).
+		Mode enums.LinkMode
 	}
 
 	// NavigationBehaviours defines all navigation behaviours for the navigator.
@@ -74,6 +91,21 @@ type (
 		// Cascade controls how deep to navigate
 		//
 		Cascade CascadeBehaviour
+
+		// Links controls how symbolic links are handled
+		//
+		Links LinkBehaviour
+
+		// Order defines the order in which nodes are visited. By default, the
+		// tree is navigated depth first. When breadth first, all the nodes at
+		// one depth are visited before any node at the next depth, with the
+		// pending directories being held in a queue, known as the frontier.
+		// Note that the Ascend event for a directory is dispatched as soon as
+		// its contents have been queued, rather than after they have been
+		// visited. For this reason, filters that follow the progress of
+		// navigation, eg the ignore filter, are rejected.
+		//
+		Order enums.TraversalOrder
 	}
 )
 
@@ -104,6 +136,25 @@ func WithSortBehaviour(sb *SortBehaviour) Option {
 	}
 }
 
+// WithLinkBehaviour defines how symbolic links are handled.
+func WithLinkBehaviour(lb *LinkBehaviour) Option {
+	return func(o *Options) error {
+		o.Behaviours.Links = *lb
+
+		return nil
+	}
+}
+
+// WithBreadthFirst sets the navigator to visit the nodes of the tree in
+// level order, ie all nodes at one depth before any at the next.
+func WithBreadthFirst() Option {
+	return func(o *Options) error {
+		o.Behaviours.Order = enums.TraversalOrderBreadthFirst
+
+		return nil
+	}
+}
+
 // WithDepth sets the maximum number of directories deep the navigator
 // will traverse to.
 func WithDepth(depth core.TraversalDepth) Option {
@@ -122,3 +173,13 @@ func WithNoRecurse() Option {
 		return nil
 	}
 }
+
+// WithOneFileSystem sets the navigator to not descend into directories
+// that reside on a different device to the tree.
+func WithOneFileSystem() Option {
+	return func(o *Options) error {
+		o.Behaviours.Cascade.OneFileSystem = true
+
+		return nil
+	}
+}
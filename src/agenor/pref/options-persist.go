@@ -7,7 +7,20 @@ import (
 type (
 	// PersistOptions defines the options for persisting data.
 	PersistOptions struct {
-		// Format specifies the format to use for persistence.
+		// Format specifies the format to use for persistence. JSON is the
+		// default, YAML is the most readable and gob is the most compact and
+		// fastest to write, which is of benefit when checkpointing large trees.
+		// When resuming, the format defaults to that of the file resumed from.
 		Format enums.PersistenceFormat
 	}
 )
+
+// WithPersistFormat defines the format in which the navigation state
+// is saved.
+func WithPersistFormat(format enums.PersistenceFormat) Option {
+	return func(o *Options) error {
+		o.Persist.Format = format
+
+		return nil
+	}
+}
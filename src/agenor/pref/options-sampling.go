@@ -15,13 +15,24 @@ type (
 		Type enums.SampleType
 
 		// InReverse determines the direction of iteration for the sampling
-		// operation
+		// operation. For the ordered sample types (size and modified), this
+		// selects the smallest/oldest entries instead of the largest/newest.
 		InReverse bool
 
 		// NoOf specifies number of items required in each sample (only applies
 		// when not using Custom iterator options)
 		NoOf EntryQuantities
 
+		// Seed determines the entries selected by random sampling. When not
+		// specified, a seed is generated at the start of a primary navigation;
+		// either way, it is persisted, so that a resumed navigation selects the
+		// same entries.
+		Seed uint64
+
+		// Stride specifies the interval k, when selecting every k-th entry with
+		// stride sampling. A stride of 0 or 1 selects every entry.
+		Stride uint
+
 		// Iteration allows the client to customise how a directory's contents are sampled.
 		// The default way to sample is either by slicing the directory's contents or
 		// by using the filter to select either the first/last n entries (using the
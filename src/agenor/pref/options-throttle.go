@@ -0,0 +1,39 @@
+package pref
+
+type (
+	// ThrottleOptions defines the rates at which the navigator is permitted
+	// to consume resources. Each rate is expressed per second and a value of
+	// 0 (the default) means that rate is not capped.
+	ThrottleOptions struct {
+		// Nodes specifies the maximum number of nodes per second that may be
+		// presented to the client.
+		Nodes uint
+
+		// Reads specifies the maximum number of directory reads per second.
+		// This includes reads performed by read ahead.
+		Reads uint
+
+		// Bytes specifies the maximum number of bytes of client I/O per second.
+		// As the navigator can not observe the I/O performed by the client, this
+		// is approximated by the size of each file presented to the client.
+		Bytes uint64
+	}
+)
+
+// IsThrottleActive determines whether any of the throttle rates have been
+// capped.
+func (o *ThrottleOptions) IsThrottleActive() bool {
+	return o.Nodes > 0 || o.Reads > 0 || o.Bytes > 0
+}
+
+// WithThrottle caps the rate at which the navigator invokes the client,
+// reads directories and the rate of client I/O, so that a traversal of a
+// shared or production file system does not saturate it. The current rates
+// are reported in the metrics.
+func WithThrottle(to *ThrottleOptions) Option {
+	return func(o *Options) error {
+		o.Throttle = *to
+
+		return nil
+	}
+}
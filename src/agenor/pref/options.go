@@ -7,6 +7,7 @@ import (
 	"runtime"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/life"
 	"github.com/snivilised/jaywalk/src/agenor/tapable"
 )
@@ -42,6 +43,10 @@ type (
 		//
 		Concurrency ConcurrencyOptions
 
+		// Throttle contains options relating to rate limiting
+		//
+		Throttle ThrottleOptions
+
 		// Events provides the ability to tap into life cycle events
 		//
 		Events life.Events
@@ -58,6 +63,10 @@ type (
 		//
 		Defects DefectOptions
 
+		// Persist contains options relating to the saving of navigation state
+		//
+		Persist PersistOptions
+
 		// Configurer represents something that can configure the options
 		//
 		Configurer TraversalConfigurer
@@ -145,6 +154,9 @@ func DefaultOptions() *Options {
 			Panic: Rescuer(DefaultPanicHandler),
 			Skip:  Asker(DefaultSkipHandler),
 		},
+		Persist: PersistOptions{
+			Format: enums.PersistJSON,
+		},
 	}
 
 	return o
@@ -0,0 +1,77 @@
+package agenor
+
+import (
+	"context"
+	"io/fs"
+	"iter"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/stock"
+)
+
+// All returns an iterator over the nodes of a walk, enabling a traversal
+// to be consumed with a range loop instead of a client callback:
+//
+//	for node, err := range agenor.All(ctx, facade, options...) {
+//		...
+//	}
+//
+// The navigation is built with Walk, as a primary navigation when the
+// facade is a *pref.Using, or as a resume when it is a *pref.Relic, so all
+// options (filters, sampling, hibernation, depth etc) apply as they do for
+// any other walk. The Handler defined on the facade is ignored, since the
+// body of the loop takes its place; the facade itself is not modified.
+//
+// Any other facade results in core.ErrWrongPrimaryFacade being yielded
+// with a nil node, without navigating.
+//
+// Breaking out of the loop terminates the traversal, in exactly the same
+// way as returning fs.SkipAll from a client callback. If the navigation
+// fails, the error is yielded with a nil node as the final iteration.
+func All(ctx context.Context,
+	facade pref.Facade,
+	settings ...pref.Option,
+) iter.Seq2[*core.Node, error] {
+	return func(yield func(*core.Node, error) bool) {
+		stopped := false
+		handler := func(servant core.Servant) error {
+			if stopped {
+				return fs.SkipAll
+			}
+
+			if !yield(servant.Node(), nil) {
+				stopped = true
+
+				return fs.SkipAll
+			}
+
+			return nil
+		}
+
+		var builders *Builders
+
+		switch f := facade.(type) {
+		case *pref.Using:
+			using := *f
+			using.Handler = handler
+			builders = Prime(&using, settings...)
+
+		case *pref.Relic:
+			relic := *f
+			relic.Handler = handler
+			builders = Resume(&relic, settings...)
+
+		default:
+			yield(nil, core.ErrWrongPrimaryFacade)
+
+			return
+		}
+
+		_, err := Walk().Configure().Extent(builders).Navigate(ctx)
+
+		if !stopped && !stock.IsBenignError(err) {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package agenor_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+var _ = Describe("All", Ordered, func() {
+	var (
+		fS    *luna.MemFS
+		using func(handler agenor.Client) *pref.Using
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(false, lab.Static.RetroWave)
+		using = func(handler agenor.Client) *pref.Using {
+			return &pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: agenor.SubscribeFiles,
+				Head: pref.Head{
+					Handler: handler,
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			}
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	When("iterating all nodes", func() {
+		It("🧪 should: yield the same nodes as walk", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				expected := []string{}
+				_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+					using(func(servant agenor.Servant) error {
+						expected = append(expected, servant.Node().Path)
+
+						return nil
+					}),
+					agenor.WithDepth(2),
+				)).Navigate(ctx)
+				Expect(err).To(Succeed())
+
+				actual := []string{}
+				for node, err := range agenor.All(ctx, using(nil), agenor.WithDepth(2)) {
+					Expect(err).To(Succeed())
+					actual = append(actual, node.Path)
+				}
+
+				Expect(actual).NotTo(BeEmpty())
+				Expect(actual).To(Equal(expected))
+			})
+		})
+	})
+
+	When("iterating a resume", func() {
+		It("🧪 should: yield the remaining nodes", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				expected := []string{}
+				for node, err := range agenor.All(ctx, using(nil)) {
+					Expect(err).To(Succeed())
+					expected = append(expected, node.Path)
+				}
+
+				visited := map[string]int{}
+				interrupted, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				var saved *locale.TraversalSavedError
+
+				for node, err := range agenor.All(interrupted, using(nil),
+					agenor.WithAdminPath(GinkgoT().TempDir()),
+				) {
+					if err != nil {
+						Expect(errors.As(err, &saved)).To(BeTrue(), "error should carry the saved path")
+
+						break
+					}
+
+					visited[node.Path]++
+
+					if len(visited) == 3 {
+						cancel()
+					}
+				}
+
+				Expect(saved).NotTo(BeNil())
+
+				for node, err := range agenor.All(ctx, &pref.Relic{
+					Head: pref.Head{
+						GetForest: using(nil).GetForest,
+					},
+					From:     saved.SavedTo,
+					Strategy: enums.ResumeStrategyFastward,
+				}) {
+					Expect(err).To(Succeed())
+					visited[node.Path]++
+				}
+
+				for _, path := range expected {
+					Expect(visited).To(HaveKey(path), "node: '%v' was missed", path)
+				}
+			})
+		})
+	})
+
+	When("breaking out of the loop", func() {
+		It("🧪 should: stop traversal", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				const limit = 3
+				count := 0
+
+				for node, err := range agenor.All(ctx, using(nil)) {
+					Expect(err).To(Succeed())
+					Expect(node).NotTo(BeNil())
+
+					count++
+					if count == limit {
+						break
+					}
+				}
+
+				Expect(count).To(Equal(limit))
+			})
+		})
+	})
+
+	When("navigation fails", func() {
+		It("🧪 should: yield the error", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				var failure error
+
+				for node, err := range agenor.All(ctx, &pref.Using{
+					Tree: lab.Static.RetroWave,
+				}) {
+					Expect(node).To(BeNil())
+					failure = err
+				}
+
+				Expect(failure).NotTo(Succeed())
+			})
+		})
+	})
+	When("facade is neither using nor relic", func() {
+		It("🧪 should: yield wrong facade error", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				type facade struct {
+					pref.Facade
+				}
+
+				failures := []error{}
+
+				for node, err := range agenor.All(ctx, &facade{}) {
+					Expect(node).To(BeNil())
+					failures = append(failures, err)
+				}
+
+				Expect(failures).To(HaveLen(1))
+				Expect(failures[0]).To(MatchError(core.ErrWrongPrimaryFacade))
+			})
+		})
+	})
+})
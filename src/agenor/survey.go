@@ -0,0 +1,56 @@
+package agenor
+
+import (
+	"context"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+)
+
+// Survey establishes the extent of the primary navigation described by the
+// facade and settings, without invoking the client. The survey is a
+// navigation in its own right, so filters, sampling and depth are all
+// honoured, but only the metadata already acquired by the navigator is
+// consulted. The nodes are counted as if subscribed to everything, so the
+// result can report the count for any subscription. Since the survey does
+// not perform any client I/O, a throttle on nodes or bytes does not apply.
+// The survey is not resumable, so neither checkpoints nor the state on
+// interrupt are saved, it is not instrumented and it does not notify the
+// life cycle event handlers, which are reserved for the navigation proper.
+func Survey(ctx context.Context,
+	facade pref.Facade,
+	settings ...pref.Option,
+) (*core.SurveyResult, error) {
+	using, ok := facade.(*pref.Using)
+	if !ok {
+		return nil, core.ErrWrongPrimaryFacade
+	}
+
+	result := &core.SurveyResult{}
+	surveyor := *using
+	surveyor.Subscription = enums.SubscribeUniversal
+	surveyor.Handler = func(servant core.Servant) error {
+		result.Tally(servant.Node())
+
+		return nil
+	}
+
+	settings = append(settings, func(o *pref.Options) error {
+		o.Throttle.Nodes = 0
+		o.Throttle.Bytes = 0
+		o.Monitor.Checkpoint = pref.CheckpointOptions{}
+		o.Monitor.SaveOnInterrupt = false
+		o.Monitor.Instrument = false
+		o.Events.OffAll()
+
+		return nil
+	})
+
+	_, err := Walk().Configure().Extent(Prime(
+		&surveyor,
+		settings...,
+	)).Navigate(ctx)
+
+	return result, err
+}
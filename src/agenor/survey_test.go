@@ -0,0 +1,193 @@
+package agenor_test
+
+import (
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snivilised/jaywalk/src/agenor"
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
+	"github.com/snivilised/jaywalk/src/agenor/life"
+	"github.com/snivilised/jaywalk/src/agenor/pref"
+	"github.com/snivilised/jaywalk/src/agenor/test/hanno"
+	"github.com/snivilised/jaywalk/src/agenor/tfs"
+	"github.com/snivilised/jaywalk/src/internal/services"
+	"github.com/snivilised/jaywalk/src/locale"
+	lab "github.com/snivilised/jaywalk/test/laboratory"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type surveyTE struct {
+	lab.DescribedTE
+	settings func() []pref.Option
+}
+
+var _ = Describe("Survey", Ordered, func() {
+	var (
+		fS    *luna.MemFS
+		using func(handler agenor.Client) *pref.Using
+	)
+
+	BeforeAll(func() {
+		Expect(li18ngo.Register(
+			func(o *li18ngo.UseOptions) {
+				o.From.Sources = li18ngo.TranslationFiles{
+					locale.SourceID: li18ngo.TranslationSource{Name: "agenor"},
+				}
+			},
+		)).To(Succeed())
+
+		fS = hanno.Nuxx(false, lab.Static.RetroWave)
+		using = func(handler agenor.Client) *pref.Using {
+			return &pref.Using{
+				Tree:         lab.Static.RetroWave,
+				Subscription: agenor.SubscribeFiles,
+				Head: pref.Head{
+					Handler: handler,
+					GetForest: func(_ string) *core.Forest {
+						return &core.Forest{
+							T: fS,
+							R: tfs.New(),
+						}
+					},
+				},
+			}
+		}
+	})
+
+	BeforeEach(func() {
+		services.Reset()
+	})
+
+	DescribeTable("extent",
+		func(specCtx SpecContext, entry *surveyTE) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				expected := &core.SurveyResult{}
+				facade := using(func(servant agenor.Servant) error {
+					expected.Tally(servant.Node())
+
+					return nil
+				})
+				facade.Subscription = agenor.SubscribeUniversal
+
+				_, err := agenor.Walk().Configure().Extent(agenor.Prime(
+					facade,
+					entry.settings()...,
+				)).Navigate(ctx)
+				Expect(err).To(Succeed())
+
+				invoked := false
+				survey, err := agenor.Survey(ctx, using(func(_ agenor.Servant) error {
+					invoked = true
+
+					return nil
+				}), entry.settings()...)
+
+				Expect(err).To(Succeed())
+				Expect(invoked).To(BeFalse(), "client should not be invoked")
+				Expect(survey.Files).To(BeNumerically(">", 0))
+				Expect(survey).To(Equal(expected))
+				Expect(survey.Count(enums.SubscribeUniversal)).To(
+					Equal(survey.Files + survey.Directories),
+				)
+			})
+		},
+		func(entry *surveyTE) string {
+			return lab.FormatTestDescription(&entry.DescribedTE)
+		},
+
+		Entry(nil, &surveyTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "no settings",
+				Should: "count all nodes",
+			},
+			settings: func() []pref.Option {
+				return []pref.Option{}
+			},
+		}),
+
+		Entry(nil, &surveyTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "depth",
+				Should: "count nodes within depth",
+			},
+			settings: func() []pref.Option {
+				return []pref.Option{agenor.WithDepth(2)}
+			},
+		}),
+
+		Entry(nil, &surveyTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "filter",
+				Should: "count only matching nodes",
+			},
+			settings: func() []pref.Option {
+				return []pref.Option{agenor.WithFilter(&pref.FilterOptions{
+					Node: &core.FilterDef{
+						Type:        enums.FilterTypeGlob,
+						Description: "flac files",
+						Pattern:     "*.flac",
+						Scope:       enums.ScopeFile,
+					},
+				})}
+			},
+		}),
+
+		Entry(nil, &surveyTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "sample",
+				Should: "count only sampled nodes",
+			},
+			settings: func() []pref.Option {
+				return []pref.Option{agenor.WithSamplingOptions(&pref.SamplingOptions{
+					Type: enums.SampleTypeSlice,
+					NoOf: pref.EntryQuantities{
+						Files:       1,
+						Directories: 1,
+					},
+				})}
+			},
+		}),
+	)
+
+	When("checkpoint and life cycle events are requested", func() {
+		It("🧪 should: neither write checkpoints nor notify", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				admin := filepath.Join(GinkgoT().TempDir(), "admin")
+				begun := false
+
+				_, err := agenor.Survey(ctx, using(func(_ agenor.Servant) error {
+					return nil
+				}),
+					agenor.WithAdminPath(admin),
+					agenor.WithCheckpoint(&pref.CheckpointOptions{
+						Nodes: 1,
+					}),
+					agenor.WithInterruptSave(),
+					agenor.WithInstrumentation(),
+					agenor.WithOnBegin(func(_ *life.BeginState) {
+						begun = true
+					}),
+				)
+
+				Expect(err).To(Succeed())
+				Expect(admin).NotTo(BeADirectory(), "no files should be written")
+				Expect(begun).To(BeFalse(), "begin should not be notified")
+			})
+		})
+	})
+
+	When("facade is not for a primary navigation", func() {
+		It("🧪 should: return error", func(specCtx SpecContext) {
+			lab.WithTestContext(specCtx, func(ctx context.Context, _ context.CancelFunc) {
+				_, err := agenor.Survey(ctx, &pref.Relic{})
+
+				Expect(err).To(MatchError(core.ErrWrongPrimaryFacade))
+			})
+		})
+	})
+})
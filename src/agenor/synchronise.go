@@ -2,6 +2,11 @@ package agenor
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/internal/enclave"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/pants"
@@ -33,17 +38,116 @@ func (t *trunk) Bye(result *enclave.KernelResult) {
 	t.kc.Bye(result)
 }
 
+// navigate performs the outermost navigation, which is concluded once it,
+// and any child navigations it requires, have finished.
+func (t *trunk) navigate(ctx context.Context) (*enclave.KernelResult, error) {
+	result, err := t.kc.Navigate(ctx)
+	t.kc.Conclude(err)
+
+	return result, err
+}
+
+// intercept derives a context that is cancelled on receipt of an interrupt
+// or termination signal, if requested, so that the navigation state is
+// saved before navigation returns.
+func (t *trunk) intercept(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.o == nil || !t.o.Monitor.SaveOnInterrupt {
+		return ctx, func() {}
+	}
+
+	return signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+}
+
 type concurrent struct {
 	trunk
-	wg pants.WaitGroup
+	wg        pants.WaitGroup
+	swappable enclave.Swapper
+	pool      *core.TraversePool
 }
 
+// Navigate performs a concurrent traversal. The navigator itself still
+// runs on the calling go-routine, but instead of invoking the client
+// directly, each eligible servant is posted to a worker pool of size
+// NoW. Navigate does not return until the pool has drained, so when the
+// result is received, all client callbacks have completed.
 func (c *concurrent) Navigate(ctx context.Context) (*enclave.KernelResult, error) {
 	if c.err != nil {
 		return c.kc.Result(ctx), c.err
 	}
 
-	return c.kc.Navigate(ctx)
+	if err := c.open(ctx); err != nil {
+		return c.kc.Result(ctx), err
+	}
+
+	defer c.close(ctx)
+
+	// the pool continues with the parent context, so that the jobs already
+	// posted are completed when navigation is interrupted
+	//
+	navigation, stop := c.intercept(ctx)
+	defer stop()
+
+	return c.navigate(navigation)
+}
+
+func (c *concurrent) open(ctx context.Context) error {
+	output := c.o.Concurrency.Output
+	options := []pants.Option{
+		pants.WithSize(c.o.Concurrency.NoW),
+		pants.WithInput(c.o.Concurrency.Input.Size),
+	}
+
+	if output.On != nil {
+		options = append(options, pants.WithOutput(
+			output.Size, output.CheckCloseInterval, output.TimeoutOnSend,
+		))
+	}
+
+	pool, err := pants.NewManifoldFuncPool(ctx, c.work, c.wg, options...)
+	if err != nil {
+		return err
+	}
+
+	c.pool = pool
+
+	if output.On != nil {
+		output.On(pool.Observe())
+	}
+
+	// the handler being replaced paces and times the client, so it is
+	// this that is posted, rather than the client itself, in order that
+	// the callback is paced and timed on the worker that invokes it.
+	//
+	var handler core.Client
+
+	handler = c.swappable.Swap(func(servant core.Servant) error {
+		return c.pool.Post(ctx, &core.TraverseInput{
+			Servant: servant,
+			Handler: handler,
+		})
+	})
+
+	return nil
+}
+
+// close concludes the pool, indicating no more jobs will be posted, then
+// waits for all outstanding jobs to complete before releasing the pool.
+func (c *concurrent) close(ctx context.Context) {
+	c.pool.Conclude(ctx)
+	c.wg.Wait()
+	c.pool.Release(ctx)
+}
+
+// work is the job executed by the pool for each posted servant. The
+// error returned by the client does not affect navigation; it is
+// delivered with the output, if requested.
+func (c *concurrent) work(input *core.TraverseInput) (*core.TraverseOutput, error) {
+	err := input.Handler(input.Servant)
+
+	return &core.TraverseOutput{
+		Servant: input.Servant,
+		Error:   err,
+	}, err
 }
 
 type sequential struct {
@@ -55,5 +159,8 @@ func (s *sequential) Navigate(ctx context.Context) (*enclave.KernelResult, error
 		return s.kc.Result(ctx), s.err
 	}
 
-	return s.kc.Navigate(ctx)
+	navigation, stop := s.intercept(ctx)
+	defer stop()
+
+	return s.navigate(navigation)
 }
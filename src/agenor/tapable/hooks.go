@@ -24,7 +24,8 @@ type (
 
 		// QueryStatus is a hook that allows clients to override the querying of
 		// a node's status. QueryStatus is only used for the top node of a traversal,
-		// and is not used for any other nodes. This is because the top node is the
+		// and when resuming, for the tree and the ancestors of the resume point; it
+		// is not used for any other nodes. This is because the top node is the
 		// only node that is not guaranteed to exist in the forest, and therefore
 		// may require special handling.
 		QueryStatus Hook[core.QueryStatusHook, core.ChainQueryStatusHook]
@@ -0,0 +1,32 @@
+//go:build !windows
+
+package tfs
+
+import (
+	"io/fs"
+	"syscall"
+)
+
+func identityOf(info fs.FileInfo) (Identity, bool) {
+	status, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || status == nil {
+		return Identity{}, false
+	}
+
+	return Identity{
+		Device: uint64(status.Dev), //nolint:gosec,unconvert // width varies by platform
+		Inode:  uint64(status.Ino), //nolint:gosec,unconvert // width varies by platform
+	}, true
+}
+
+func ownerOf(info fs.FileInfo) (Owner, bool) {
+	status, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || status == nil {
+		return Owner{}, false
+	}
+
+	return Owner{
+		UID: status.Uid,
+		GID: status.Gid,
+	}, true
+}
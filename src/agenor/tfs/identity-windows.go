@@ -0,0 +1,20 @@
+//go:build windows
+
+package tfs
+
+import (
+	"io/fs"
+)
+
+// identityOf is not supported on windows, because the file index is not
+// available from the FileInfo returned by Stat without re-opening the
+// file; callers fall back to comparing paths.
+func identityOf(_ fs.FileInfo) (Identity, bool) {
+	return Identity{}, false
+}
+
+// ownerOf is not supported on windows, since ownership is defined by
+// security descriptors rather than numeric ids.
+func ownerOf(_ fs.FileInfo) (Owner, bool) {
+	return Owner{}, false
+}
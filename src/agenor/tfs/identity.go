@@ -0,0 +1,40 @@
+package tfs
+
+import (
+	"io/fs"
+)
+
+// Identity uniquely identifies a file system entity on the host, by the
+// device it resides on and its inode (or equivalent) on that device.
+type Identity struct {
+	Device uint64
+	Inode  uint64
+}
+
+// IdentityOf returns the Identity of the entity described by info. The
+// identity is only available when info originates from the host file
+// system (ie info.Sys() holds the platform's native status); false is
+// returned otherwise, eg for in memory file systems.
+func IdentityOf(info fs.FileInfo) (Identity, bool) {
+	if info == nil {
+		return Identity{}, false
+	}
+
+	return identityOf(info)
+}
+
+// Owner identifies the user and group that own a file system entity.
+type Owner struct {
+	UID uint32
+	GID uint32
+}
+
+// OwnerOf returns the Owner of the entity described by info. As with
+// IdentityOf, this is only available for entities of the host file system.
+func OwnerOf(info fs.FileInfo) (Owner, bool) {
+	if info == nil {
+		return Owner{}, false
+	}
+
+	return ownerOf(info)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/snivilised/jaywalk/src/agenor/core"
 	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/agenor/internal/filtering"
+	"github.com/snivilised/jaywalk/src/agenor/internal/persist"
 	"github.com/snivilised/jaywalk/src/agenor/pref"
 	"github.com/snivilised/jaywalk/src/agenor/tfs"
 	nef "github.com/snivilised/nefilim"
@@ -77,6 +78,10 @@ type (
 	// resolved after filtering.
 	PeerInfo = core.PeerInfo
 
+	// SurveyResult is the outcome of a survey, which establishes the extent
+	// of a navigation prior to it being performed.
+	SurveyResult = core.SurveyResult
+
 	// 🌀 enums
 
 	// Subscription represents the types of file system nodes that can be subscribed to
@@ -89,6 +94,10 @@ type (
 	// whether to spawn new sessions or fast-forward to the last known state.
 	ResumeStrategy = enums.ResumeStrategy
 
+	// DriftPolicy represents how a resume responds to the tree having changed
+	// since the navigation state was saved.
+	DriftPolicy = enums.DriftPolicy
+
 	// 🌀 nef
 
 	// ExistsInFS contains methods that check the existence of file system items.
@@ -109,6 +118,19 @@ type (
 	// WriterFS contains methods for writing files and directories in a file system.
 	WriterFS = nef.WriterFS
 
+	// 🌀 persist
+
+	// Checkpoint describes a file containing saved navigation state.
+	Checkpoint = persist.Checkpoint
+
+	// CheckpointCatalogue lists, inspects, validates and prunes the checkpoint
+	// files saved within an admin path.
+	CheckpointCatalogue = persist.Catalogue
+
+	// CheckpointDetail is the content of a checkpoint file, including the tree,
+	// current path, subscription, metrics and a summary of the saved options.
+	CheckpointDetail = persist.CheckpointDetail
+
 	// 🌀 pref
 
 	// Accepter is the function signature for functions that can be accepted as options
@@ -168,6 +190,44 @@ const (
 	// spawning new sessions.
 	ResumeStrategyFastward = enums.ResumeStrategyFastward
 
+	// 🌀 enum: PersistenceFormat
+
+	// PersistJSON indicates that navigation state is saved as JSON.
+	PersistJSON = enums.PersistJSON
+
+	// PersistYAML indicates that navigation state is saved as YAML.
+	PersistYAML = enums.PersistYAML
+
+	// PersistGob indicates that navigation state is saved in the compact
+	// binary gob format.
+	PersistGob = enums.PersistGob
+
+	// 🌀 enum: DriftPolicy
+
+	// DriftWarn indicates that tree drift detected on resume is logged as a
+	// warning and navigation resumes regardless.
+	DriftWarn = enums.DriftWarn
+
+	// DriftFail indicates that tree drift detected on resume fails the resume.
+	DriftFail = enums.DriftFail
+
+	// DriftNearest indicates that when tree drift is detected on resume,
+	// navigation resumes from the nearest surviving ancestor.
+	DriftNearest = enums.DriftNearest
+
+	// 🌀 enum: LinkMode
+
+	// LinkModeIgnore indicates that symbolic links are not delivered to the client.
+	LinkModeIgnore = enums.LinkModeIgnore
+
+	// LinkModeReport indicates that symbolic links are delivered to the client, with
+	// the link target populated on the node, but are not followed. This is the default.
+	LinkModeReport = enums.LinkModeReport
+
+	// LinkModeFollow indicates that symbolic links to directories are followed and
+	// navigated as if they were regular directories.
+	LinkModeFollow = enums.LinkModeFollow
+
 	// 🌀 enum:Subscribe
 
 	// SubscribeFiles indicates that the client wants to receive callbacks for file nodes
@@ -214,6 +274,17 @@ var (
 	// a custom sample filter.
 	NewCustomSampleFilter = filtering.NewCustomSampleFilter
 
+	// RegisterFilter registers the factory that creates the custom filter
+	// identified by name. A custom filter defined by name (see NamedFilterDef),
+	// rather than by instance, is re-created automatically on resume.
+	RegisterFilter = filtering.Register
+
+	// 🌀 persist
+
+	// Checkpoints creates a catalogue of the checkpoint files, ie the files
+	// containing saved navigation state, within the admin path.
+	Checkpoints = persist.NewCatalogue
+
 	// 🌀 pref
 
 	// IfOption enables options to be conditional. IfOption condition evaluates to true
@@ -238,11 +309,20 @@ var (
 	// WithAdminPath defines the path for admin related files
 	WithAdminPath = pref.WithAdminPath
 
+	// WithBreadthFirst sets the navigator to visit the nodes of the tree in
+	// level order, ie all nodes at one depth before any at the next.
+	WithBreadthFirst = pref.WithBreadthFirst
+
 	// WithCPU configures the worker pool used for concurrent traversal sessions
 	// in the Sprint function to utilise a number of go-routines equal to the available
 	// CPU count, optimising performance based on the system's processing capabilities.
 	WithCPU = pref.WithCPU
 
+	// WithCheckpoint requests that the navigation state is saved in the
+	// background on a schedule, so that navigation can be resumed from near
+	// where it stopped, if the process is terminated abruptly.
+	WithCheckpoint = pref.WithCheckpoint
+
 	// WithDepth sets the maximum number of directories deep the navigator
 	// will traverse to.
 	WithDepth = pref.WithDepth
@@ -255,7 +335,8 @@ var (
 
 	// WithFilter used to determine which file system nodes (files or directories)
 	// the client defined handler is invoked for. Note that the filter does not
-	// determine navigation, it only determines wether the callback is invoked.
+	// determine navigation, it only determines wether the callback is invoked,
+	// unless a Prune filter is defined.
 	WithFilter = pref.WithFilter
 
 	// WithHibernationBehaviourExclusiveWake activates hibernation
@@ -272,6 +353,11 @@ var (
 	// for hibernation based traversal sessions.
 	WithHibernationFilterSleep = pref.WithHibernationFilterSleep
 
+	// WithHibernationFilterToggle defines the toggle condition for
+	// hibernation based traversal sessions, where each matching node
+	// flips hibernation between dormant and active.
+	WithHibernationFilterToggle = pref.WithHibernationFilterToggle
+
 	// WithHibernationFilterWake defines the wake condition
 	// for hibernation based traversal sessions.
 	WithHibernationFilterWake = pref.WithHibernationFilterWake
@@ -280,6 +366,10 @@ var (
 	// session.
 	WithHibernationOptions = pref.WithHibernationOptions
 
+	// WithHibernationTriggers defines wake and sleep conditions based on
+	// counts, depth and time, which compose with the wake and sleep filters.
+	WithHibernationTriggers = pref.WithHibernationTriggers
+
 	// WithHookCaseSensitiveSort specifies that a directory's contents
 	// should be sorted with case sensitivity.
 	WithHookCaseSensitiveSort = pref.WithHookCaseSensitiveSort
@@ -304,12 +394,30 @@ var (
 	// default behaviour for sorting a directory's contents.
 	WithHookSort = pref.WithHookSort
 
+	// WithInstrumentation enables the recording of the durations of directory
+	// reads, status queries and client callbacks, available from the
+	// TraverseResult as histograms (see Timings).
+	WithInstrumentation = pref.WithInstrumentation
+
+	// WithInterruptSave requests that the navigation state is saved when
+	// the process is interrupted (ctrl-c) or terminated, so that the
+	// navigation can be resumed.
+	WithInterruptSave = pref.WithInterruptSave
+
+	// WithLinkBehaviour defines how symbolic links are handled; ie whether
+	// they are ignored, reported or followed.
+	WithLinkBehaviour = pref.WithLinkBehaviour
+
 	// WithLogger defines a structure logger
 	WithLogger = pref.WithLogger
 
 	// WithNavigationBehaviours defines all navigation behaviours
 	WithNavigationBehaviours = pref.WithNavigationBehaviours
 
+	// WithOneFileSystem sets the navigator to not descend into directories
+	// that reside on a different device to the tree, akin to find -xdev.
+	WithOneFileSystem = pref.WithOneFileSystem
+
 	// WithOnAscend sets ascend handler, invoked when navigator
 	// traverses up a directory, ie after all children have been
 	// visited.
@@ -319,6 +427,10 @@ var (
 	// of a traversal session.
 	WithOnBegin = pref.WithOnBegin
 
+	// WithOnCycle sets the cycle handler, invoked when a followed
+	// symbolic link resolves to one of its own ancestor directories.
+	WithOnCycle = pref.WithOnCycle
+
 	// WithOnDescend sets the descend handler, invoked when navigator
 	// traverses down into a child directory.
 	WithOnDescend = pref.WithOnDescend
@@ -351,6 +463,14 @@ var (
 	// the Sprint function.
 	WithNoW = pref.WithNoW
 
+	// WithPersistFormat defines the format in which the navigation state
+	// is saved; JSON, YAML or gob.
+	WithPersistFormat = pref.WithPersistFormat
+
+	// WithReadAhead requests that the contents of sub-directories are
+	// prefetched on n go-routines, without affecting delivery order.
+	WithReadAhead = pref.WithReadAhead
+
 	// WithSamplingOptions specifies the sampling options.
 	// SampleType: the type of sampling to use
 	// SampleInReverse: determines the direction of iteration for the sampling
@@ -376,4 +496,8 @@ var (
 
 	// WithSubPathBehaviour defines all sub-path behaviours.
 	WithSubPathBehaviour = pref.WithSubPathBehaviour
+
+	// WithThrottle caps the rate at which nodes are presented to the client,
+	// directories are read and the bytes of client I/O.
+	WithThrottle = pref.WithThrottle
 )
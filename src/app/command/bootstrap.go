@@ -83,6 +83,7 @@ type navState struct {
 	previewFam  *assist.ParamSet[store.PreviewParameterSet]
 	cascadeFam  *assist.ParamSet[store.CascadeParameterSet]
 	samplingFam *assist.ParamSet[store.SamplingParameterSet]
+	rankFam     *assist.ParamSet[RankParameterSet]
 	polyFam     *assist.ParamSet[store.PolyFilterParameterSet]
 }
 
@@ -476,6 +477,30 @@ func (b *Bootstrap) bindNavFlags(cmd *cobra.Command, ns *navState) {
 	ns.samplingFam = assist.NewParamSet[store.SamplingParameterSet](cmd)
 	ns.samplingFam.Native.BindAll(ns.samplingFam, fs)
 
+	// family: rank [--rank] complements the sampling family, which can only
+	// select by name
+	ns.rankFam = assist.NewParamSet[RankParameterSet](cmd)
+	ns.rankFam.BindString(
+		assist.NewFlagInfoOnFlagSet(
+			li18ngo.Text(locale.RankFlagDescTemplData{}),
+			"",
+			"",
+			fs,
+		),
+		&ns.rankFam.Native.Rank,
+	)
+
+	// --sample-glob also complements the sampling family
+	ns.navPs.BindString(
+		assist.NewFlagInfoOnFlagSet(
+			li18ngo.Text(locale.SampleGlobFlagDescTemplData{}),
+			"",
+			"",
+			fs,
+		),
+		&ns.navPs.Native.SampleGlob,
+	)
+
 	// family: poly-filter [--files-glob, --file-regex, --folders-glob, --folders-regex]
 	ns.polyFam = assist.NewParamSet[store.PolyFilterParameterSet](cmd)
 	ns.polyFam.Native.BindAll(ns.polyFam, fs)
@@ -515,6 +540,8 @@ func navFamilies(ns *navState) NavFamilies {
 		Preview:  ns.previewFam,
 		Cascade:  ns.cascadeFam,
 		Sampling: ns.samplingFam,
+		Rank:     ns.rankFam,
 		PolyFam:  ns.polyFam,
+		NavPs:    ns.navPs,
 	}
 }
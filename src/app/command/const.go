@@ -46,3 +46,14 @@ const (
 	ResumeStrategySpawn    = "spawn"
 	ResumeStrategyFastward = "fast"
 )
+
+// ---------------------------------------------------------------------------
+// Sampling rank values
+// ---------------------------------------------------------------------------
+
+const (
+	RankLargest  = "largest"
+	RankSmallest = "smallest"
+	RankNewest   = "newest"
+	RankOldest   = "oldest"
+)
@@ -17,6 +17,7 @@ type NavFamilies struct {
 	Preview  *assist.ParamSet[store.PreviewParameterSet]
 	Cascade  *assist.ParamSet[store.CascadeParameterSet]
 	Sampling *assist.ParamSet[store.SamplingParameterSet]
+	Rank     *assist.ParamSet[RankParameterSet]
 	PolyFam  *assist.ParamSet[store.PolyFilterParameterSet]
 
 	// Tree is the positional directory argument.
@@ -26,8 +27,8 @@ type NavFamilies struct {
 	// terminal is routed through this interface.
 	UI report.Presenter
 
-	// NavPs holds the nav-level flags (subscribe, action, pipeline)
-	// inherited from the ghost nav command.
+	// NavPs holds the nav-level flags (subscribe, action, pipeline,
+	// sample-glob) inherited from the ghost nav command.
 	NavPs *assist.ParamSet[NavParameterSet]
 
 	// ExecPs holds the exec-level flags (resume) inherited from the
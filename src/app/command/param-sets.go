@@ -63,6 +63,7 @@ const (
 	WalkPreviewFamName  = "walk-preview"
 	WalkCascadeFamName  = "walk-cascade"
 	WalkSamplingFamName = "walk-sampling"
+	WalkRankFamName     = "walk-rank"
 	WalkPolyFamName     = "walk-poly"
 
 	// sprint
@@ -71,6 +72,7 @@ const (
 	SprintPreviewFamName    = "sprint-preview"
 	SprintCascadeFamName    = "sprint-cascade"
 	SprintSamplingFamName   = "sprint-sampling"
+	SprintRankFamName       = "sprint-rank"
 	SprintPolyFamName       = "sprint-poly"
 	SprintWorkerPoolFamName = "sprint-worker-pool"
 
@@ -79,6 +81,7 @@ const (
 	QueryPreviewFamName  = "query-preview"
 	QueryCascadeFamName  = "query-cascade"
 	QuerySamplingFamName = "query-sampling"
+	QueryRankFamName     = "query-rank"
 	QueryPolyFamName     = "query-poly"
 )
 
@@ -103,6 +106,27 @@ type NavParameterSet struct {
 	// Pipeline names the config-defined pipeline to execute.
 	// Maps to --pipeline(-p).
 	Pipeline string
+
+	// SampleGlob is the extended glob pattern (base|ext,ext) that selects
+	// the files eligible for sampling. Maps to --sample-glob.
+	SampleGlob string
+}
+
+// ---------------------------------------------------------------------------
+// Rank parameter set
+// ---------------------------------------------------------------------------
+
+// RankParameterSet complements the sampling family, which can only select
+// entries by name, with the order in which sampled entries are chosen.
+// It is bound alongside the sampling family on each navigation leaf command.
+type RankParameterSet struct {
+	store.ParameterSetWithOverrides
+
+	// Rank selects the order in which sampled entries are chosen when
+	// sampling is active. Valid values: "largest", "smallest", "newest",
+	// "oldest". Empty means by name. The rank determines the direction,
+	// so it can not be combined with --last. Maps to --rank.
+	Rank string
 }
 
 // ---------------------------------------------------------------------------
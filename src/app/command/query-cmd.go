@@ -28,6 +28,7 @@ func (b *Bootstrap) buildQueryCommand(container *assist.CobraContainer) {
 	container.MustRegisterParamSet(QueryPreviewFamName, b.query.previewFam)
 	container.MustRegisterParamSet(QueryCascadeFamName, b.query.cascadeFam)
 	container.MustRegisterParamSet(QuerySamplingFamName, b.query.samplingFam)
+	container.MustRegisterParamSet(QueryRankFamName, b.query.rankFam)
 	container.MustRegisterParamSet(QueryPolyFamName, b.query.polyFam)
 
 	container.MustRegisterRootedCommand(queryCmd)
@@ -46,10 +47,12 @@ func (b *Bootstrap) runQuery(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	settings := controller.BuildTraversalSettings(
-		createTraversalSettingsIntent(navFamilies(&b.query.navState)),
-		b.UI,
-	)
+	intent, err := createTraversalSettingsIntent(navFamilies(&b.query.navState))
+	if err != nil {
+		return err
+	}
+
+	settings := controller.BuildTraversalSettings(intent, b.UI)
 
 	base := controller.Request{
 		Subscription: subscription,
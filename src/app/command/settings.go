@@ -6,15 +6,34 @@ import (
 
 	"github.com/snivilised/jaywalk/src/agenor"
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/app/controller"
 	"github.com/snivilised/jaywalk/src/locale"
+	"github.com/snivilised/jaywalk/src/third/lo"
 )
 
-func createTraversalSettingsIntent(families NavFamilies) controller.TraversalSettingsIntent {
+func createTraversalSettingsIntent(families NavFamilies) (controller.TraversalSettingsIntent, error) {
+	sampleType, inReverse, err := resolveSampleRank(
+		families.Rank.Native.Rank, families.Sampling.Native.Last,
+	)
+	if err != nil {
+		return controller.TraversalSettingsIntent{}, err
+	}
+
+	sampleGlob := lo.Ternary(families.Sampling.Native.IsSampling,
+		families.NavPs.Native.SampleGlob, "",
+	)
+
+	if sampleGlob != "" && sampleType == enums.SampleTypeSlice {
+		sampleType = enums.SampleTypeFilter
+	}
+
 	return controller.TraversalSettingsIntent{
 		NoRecurse:     families.Cascade.Native.NoRecurse,
 		Depth:         core.TraversalDepth(families.Cascade.Native.Depth),
 		IsSampling:    families.Sampling.Native.IsSampling,
+		SampleType:    sampleType,
+		InReverse:     inReverse,
 		NoFiles:       families.Sampling.Native.NoFiles,
 		NoDirectories: families.Sampling.Native.NoDirectories,
 		Filter: controller.FilterIntent{
@@ -22,7 +41,38 @@ func createTraversalSettingsIntent(families NavFamilies) controller.TraversalSet
 			FilesRegEx:       families.PolyFam.Native.FilesRegEx,
 			DirectoriesGlob:  families.PolyFam.Native.DirectoriesGlob,
 			DirectoriesRegEx: families.PolyFam.Native.DirectoriesRegEx,
+			SampleGlobEx:     sampleGlob,
 		},
+	}, nil
+}
+
+// resolveSampleRank maps the --rank flag string to the sample type and
+// direction that selects entries in that order. No rank means sampling by
+// name, in which case --last determines the direction; otherwise the rank
+// determines the direction, so --last is rejected.
+func resolveSampleRank(rank string, last bool) (enums.SampleType, bool, error) {
+	if rank != "" && last {
+		return enums.SampleTypeUndefined, false, locale.ErrRankWithLast
+	}
+
+	switch rank {
+	case "":
+		return enums.SampleTypeSlice, last, nil
+	case RankLargest:
+		return enums.SampleTypeSize, false, nil
+	case RankSmallest:
+		return enums.SampleTypeSize, true, nil
+	case RankNewest:
+		return enums.SampleTypeModified, false, nil
+	case RankOldest:
+		return enums.SampleTypeModified, true, nil
+	default:
+		return enums.SampleTypeUndefined, false, locale.NewInvalidRankValueError(
+			rank,
+			fmt.Sprintf("'%s'", strings.Join([]string{
+				RankLargest, RankSmallest, RankNewest, RankOldest,
+			}, ", ")),
+		)
 	}
 }
 
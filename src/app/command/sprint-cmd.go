@@ -34,6 +34,7 @@ func (b *Bootstrap) buildSprintCommand(container *assist.CobraContainer) {
 	container.MustRegisterParamSet(SprintPreviewFamName, b.sprint.previewFam)
 	container.MustRegisterParamSet(SprintCascadeFamName, b.sprint.cascadeFam)
 	container.MustRegisterParamSet(SprintSamplingFamName, b.sprint.samplingFam)
+	container.MustRegisterParamSet(SprintRankFamName, b.sprint.rankFam)
 	container.MustRegisterParamSet(SprintPolyFamName, b.sprint.polyFam)
 	container.MustRegisterParamSet(SprintWorkerPoolFamName, b.sprint.workerPoolFam)
 
@@ -48,10 +49,16 @@ func (b *Bootstrap) runSprint(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	settings := controller.BuildTraversalSettings(
-		createTraversalSettingsIntent(navFamilies(&b.sprint.navState)),
-		b.UI,
-	)
+	intent, err := createTraversalSettingsIntent(navFamilies(&b.sprint.navState))
+	if err != nil {
+		return err
+	}
+
+	settings := controller.BuildTraversalSettings(intent, b.UI)
+
+	// ctrl-c saves the navigation state, so that it can be resumed via --resume
+	//
+	settings = append(settings, agenor.WithInterruptSave())
 
 	if b.sprint.workerPoolFam.Native.CPU {
 		settings = append(settings, agenor.WithCPU())
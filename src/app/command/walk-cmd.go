@@ -27,6 +27,7 @@ func (b *Bootstrap) buildWalkCommand(container *assist.CobraContainer) {
 	container.MustRegisterParamSet(WalkPreviewFamName, b.walk.previewFam)
 	container.MustRegisterParamSet(WalkCascadeFamName, b.walk.cascadeFam)
 	container.MustRegisterParamSet(WalkSamplingFamName, b.walk.samplingFam)
+	container.MustRegisterParamSet(WalkRankFamName, b.walk.rankFam)
 	container.MustRegisterParamSet(WalkPolyFamName, b.walk.polyFam)
 
 	walkCmd.MarkFlagsOneRequired("action", "pipeline")
@@ -40,10 +41,17 @@ func (b *Bootstrap) runWalk(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	settings := controller.BuildTraversalSettings(
-		createTraversalSettingsIntent(navFamilies(&b.walk.navState)),
-		b.UI,
-	)
+	intent, err := createTraversalSettingsIntent(navFamilies(&b.walk.navState))
+	if err != nil {
+		return err
+	}
+
+	settings := controller.BuildTraversalSettings(intent, b.UI)
+
+	// ctrl-c saves the navigation state, so that it can be resumed via --resume
+	//
+	settings = append(settings, agenor.WithInterruptSave())
+
 	isPrime := b.walk.execPs.Native.Resume == ""
 
 	base := controller.Request{
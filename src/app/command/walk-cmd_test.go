@@ -101,6 +101,22 @@ var _ = Describe("NavigatorUniversal", Ordered, func() {
 			},
 		}),
 
+		Entry(nil, &lab.GeneralTE{
+			DescribedTE: lab.DescribedTE{
+				Given:  "walk invoked sampling with both rank and last",
+				Should: "🧪 result in rank with last error",
+			},
+			NaviTE: lab.NaviTE{
+				Args: []string{
+					"walk", "RETRO-WAVE/Chromatics/Night Drive", "--action", "echo", "--theme", "system",
+					"--sample", "--last", "--rank", "largest",
+				},
+				Asserter: func(err error) {
+					Expect(err).To(MatchError(locale.ErrRankWithLast))
+				},
+			},
+		}),
+
 		Entry(nil, &lab.GeneralTE{
 			DescribedTE: lab.DescribedTE{
 				Given:  "walk invoked with filter",
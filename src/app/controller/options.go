@@ -14,12 +14,15 @@ type FilterIntent struct {
 	FilesRegEx       string
 	DirectoriesGlob  string
 	DirectoriesRegEx string
+	SampleGlobEx     string
 }
 
 type TraversalSettingsIntent struct {
 	NoRecurse     bool
 	Depth         core.TraversalDepth
 	IsSampling    bool
+	SampleType    enums.SampleType
+	InReverse     bool
 	NoFiles       uint
 	NoDirectories uint
 	Filter        FilterIntent
@@ -38,6 +41,8 @@ func BuildTraversalSettings(intent TraversalSettingsIntent, ui report.Presenter)
 
 	if intent.IsSampling {
 		opts = append(opts, agenor.WithSamplingOptions(&pref.SamplingOptions{
+			Type:      intent.SampleType,
+			InReverse: intent.InReverse,
 			NoOf: pref.EntryQuantities{
 				Files:       intent.NoFiles,
 				Directories: intent.NoDirectories,
@@ -59,11 +64,26 @@ func TranslateFilterIntent(intent FilterIntent) (pref.Option, bool) {
 	hasFileRegex := intent.FilesRegEx != ""
 	hasFolderGlob := intent.DirectoriesGlob != ""
 	hasFolderRegex := intent.DirectoriesRegEx != ""
+	hasSampleGlob := intent.SampleGlobEx != ""
+	hasNode := hasFileGlob || hasFileRegex || hasFolderGlob || hasFolderRegex
 
-	if !hasFileGlob && !hasFileRegex && !hasFolderGlob && !hasFolderRegex {
+	if !hasNode && !hasSampleGlob {
 		return nil, false
 	}
 
+	fo := &pref.FilterOptions{}
+
+	if hasSampleGlob {
+		fo.Sample = &core.SampleFilterDef{
+			Type:    enums.FilterTypeGlobEx,
+			Pattern: intent.SampleGlobEx,
+		}
+	}
+
+	if !hasNode {
+		return pref.WithFilter(fo), true
+	}
+
 	fileDef := core.BenignNodeFilterDef
 	if hasFileGlob {
 		fileDef.Type = enums.FilterTypeGlobEx
@@ -82,15 +102,15 @@ func TranslateFilterIntent(intent FilterIntent) (pref.Option, bool) {
 		dirDef.Pattern = intent.DirectoriesRegEx
 	}
 
-	return pref.WithFilter(&pref.FilterOptions{
-		Node: &core.FilterDef{
-			Type: enums.FilterTypePoly,
-			Poly: &core.PolyFilterDef{
-				File:      fileDef,
-				Directory: dirDef,
-			},
+	fo.Node = &core.FilterDef{
+		Type: enums.FilterTypePoly,
+		Poly: &core.PolyFilterDef{
+			File:      fileDef,
+			Directory: dirDef,
 		},
-	}), true
+	}
+
+	return pref.WithFilter(fo), true
 }
 
 func ResolveSubscription(flag string) (enums.Subscription, error) {
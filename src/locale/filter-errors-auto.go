@@ -9,6 +9,41 @@ import (
 	"github.com/snivilised/li18ngo"
 )
 
+// =============================================================================
+// ❌ CompoundFilterIsInvalid
+//
+// CompoundFilterIsInvalid indicates that a compound filter definition fails
+// validation; eg it is missing, has no constituents or an undefined mode.
+// =============================================================================
+
+// CompoundFilterIsInvalidErrorTemplData compound filter definition is invalid
+// error.
+type CompoundFilterIsInvalidErrorTemplData struct {
+	agenorTemplData
+}
+
+// Message creates a new i18n message using the template data.
+func (td CompoundFilterIsInvalidErrorTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "compound-filter-is-invalid.static-error",
+		Description: "compound filter definition is invalid error",
+		Other:       "compound filter definition is invalid",
+	}
+}
+
+// CompoundFilterIsInvalidError compound filter definition is invalid error.
+type CompoundFilterIsInvalidError struct {
+	li18ngo.LocalisableError
+}
+
+// ErrCompoundFilterIsInvalid is the exported sentinel error for
+// CompoundFilterIsInvalidError.
+var ErrCompoundFilterIsInvalid = CompoundFilterIsInvalidError{
+	LocalisableError: li18ngo.LocalisableError{
+		Data: CompoundFilterIsInvalidErrorTemplData{},
+	},
+}
+
 // =============================================================================
 // ❌ CoreInvalidExtGlobFilterMissingSeparator
 //
@@ -157,6 +192,345 @@ var ErrFilterCustomNotSupported = FilterCustomNotSupportedError{
 	},
 }
 
+// =============================================================================
+// ❌ FilterExpressionInvalidNumber
+//
+// FilterExpressionInvalidNumber indicates that a numeric literal within an
+// expression filter can not be parsed.
+// =============================================================================
+
+// FilterExpressionInvalidNumberTemplData invalid number in filter expression.
+type FilterExpressionInvalidNumberTemplData struct {
+	agenorTemplData
+	// Number is the invalid numeric literal
+	Number string
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionInvalidNumberTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-invalid-number.dynamic-error",
+		Description: "invalid number in filter expression",
+		Other:       "Invalid number: '{{.Number}}'",
+	}
+}
+
+// FilterExpressionInvalidNumberError invalid number in filter expression.
+type FilterExpressionInvalidNumberError struct {
+	li18ngo.LocalisableError
+	FilterExpressionInvalidNumberTemplData
+}
+
+// NewFilterExpressionInvalidNumberError creates a new
+// FilterExpressionInvalidNumberError.
+func NewFilterExpressionInvalidNumberError(number string) error {
+	td := FilterExpressionInvalidNumberTemplData{
+		agenorTemplData: agenorTemplData{},
+		Number:          number,
+	}
+	return &FilterExpressionInvalidNumberError{
+		LocalisableError:                       li18ngo.LocalisableError{Data: td},
+		FilterExpressionInvalidNumberTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterExpressionKindMismatch
+//
+// FilterExpressionKindMismatch indicates that an operand within an expression
+// filter is not of the kind required by its context, eg a size compared with a
+// duration.
+// =============================================================================
+
+// FilterExpressionKindMismatchTemplData mismatched operand kind in filter
+// expression.
+type FilterExpressionKindMismatchTemplData struct {
+	agenorTemplData
+	// Context is the operator or expression requiring the operand
+	Context string
+	// Expected is the kind of operand required
+	Expected string
+	// Actual is the kind of operand found
+	Actual string
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionKindMismatchTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-kind-mismatch.dynamic-error",
+		Description: "mismatched operand kind in filter expression",
+		Other:       "Kind mismatch for '{{.Context}}', expected: '{{.Expected}}', actual: '{{.Actual}}'",
+	}
+}
+
+// FilterExpressionKindMismatchError mismatched operand kind in filter
+// expression.
+type FilterExpressionKindMismatchError struct {
+	li18ngo.LocalisableError
+	FilterExpressionKindMismatchTemplData
+}
+
+// NewFilterExpressionKindMismatchError creates a new
+// FilterExpressionKindMismatchError.
+func NewFilterExpressionKindMismatchError(context, expected, actual string) error {
+	td := FilterExpressionKindMismatchTemplData{
+		agenorTemplData: agenorTemplData{},
+		Context:         context,
+		Expected:        expected,
+		Actual:          actual,
+	}
+	return &FilterExpressionKindMismatchError{
+		LocalisableError:                      li18ngo.LocalisableError{Data: td},
+		FilterExpressionKindMismatchTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterExpressionMissingParenthesis
+//
+// FilterExpressionMissingParenthesis indicates that a parenthesised group
+// within an expression filter is not closed.
+// =============================================================================
+
+// FilterExpressionMissingParenthesisTemplData missing closing parenthesis in
+// filter expression.
+type FilterExpressionMissingParenthesisTemplData struct {
+	agenorTemplData
+	// Position is the position of the unmatched opening parenthesis
+	Position int
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionMissingParenthesisTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-missing-parenthesis.dynamic-error",
+		Description: "missing closing parenthesis in filter expression",
+		Other:       "Missing ')' for '(' at position: '{{.Position}}'",
+	}
+}
+
+// FilterExpressionMissingParenthesisError missing closing parenthesis in filter
+// expression.
+type FilterExpressionMissingParenthesisError struct {
+	li18ngo.LocalisableError
+	FilterExpressionMissingParenthesisTemplData
+}
+
+// NewFilterExpressionMissingParenthesisError creates a new
+// FilterExpressionMissingParenthesisError.
+func NewFilterExpressionMissingParenthesisError(position int) error {
+	td := FilterExpressionMissingParenthesisTemplData{
+		agenorTemplData: agenorTemplData{},
+		Position:        position,
+	}
+	return &FilterExpressionMissingParenthesisError{
+		LocalisableError: li18ngo.LocalisableError{Data: td},
+		FilterExpressionMissingParenthesisTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterExpressionUnexpectedEnd
+//
+// FilterExpressionUnexpectedEnd indicates that an expression filter ends where
+// an operand is required.
+// =============================================================================
+
+// FilterExpressionUnexpectedEndErrorTemplData unexpected end of filter
+// expression.
+type FilterExpressionUnexpectedEndErrorTemplData struct {
+	agenorTemplData
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionUnexpectedEndErrorTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-unexpected-end.static-error",
+		Description: "unexpected end of filter expression",
+		Other:       "Unexpected end of expression",
+	}
+}
+
+// FilterExpressionUnexpectedEndError unexpected end of filter expression.
+type FilterExpressionUnexpectedEndError struct {
+	li18ngo.LocalisableError
+}
+
+// ErrFilterExpressionUnexpectedEnd is the exported sentinel error for
+// FilterExpressionUnexpectedEndError.
+var ErrFilterExpressionUnexpectedEnd = FilterExpressionUnexpectedEndError{
+	LocalisableError: li18ngo.LocalisableError{
+		Data: FilterExpressionUnexpectedEndErrorTemplData{},
+	},
+}
+
+// =============================================================================
+// ❌ FilterExpressionUnexpectedToken
+//
+// FilterExpressionUnexpectedToken indicates that an expression filter contains
+// a token that is not valid at its position.
+// =============================================================================
+
+// FilterExpressionUnexpectedTokenTemplData unexpected token in filter
+// expression.
+type FilterExpressionUnexpectedTokenTemplData struct {
+	agenorTemplData
+	// Token is the unexpected token
+	Token string
+	// Position is the position of the token within the expression
+	Position int
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionUnexpectedTokenTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-unexpected-token.dynamic-error",
+		Description: "unexpected token in filter expression",
+		Other:       "Unexpected '{{.Token}}' at position: '{{.Position}}'",
+	}
+}
+
+// FilterExpressionUnexpectedTokenError unexpected token in filter expression.
+type FilterExpressionUnexpectedTokenError struct {
+	li18ngo.LocalisableError
+	FilterExpressionUnexpectedTokenTemplData
+}
+
+// NewFilterExpressionUnexpectedTokenError creates a new
+// FilterExpressionUnexpectedTokenError.
+func NewFilterExpressionUnexpectedTokenError(token string, position int) error {
+	td := FilterExpressionUnexpectedTokenTemplData{
+		agenorTemplData: agenorTemplData{},
+		Token:           token,
+		Position:        position,
+	}
+	return &FilterExpressionUnexpectedTokenError{
+		LocalisableError:                         li18ngo.LocalisableError{Data: td},
+		FilterExpressionUnexpectedTokenTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterExpressionUnknownAttribute
+//
+// FilterExpressionUnknownAttribute indicates that an expression filter refers
+// to an attribute that is not defined.
+// =============================================================================
+
+// FilterExpressionUnknownAttributeTemplData unknown attribute in filter
+// expression.
+type FilterExpressionUnknownAttributeTemplData struct {
+	agenorTemplData
+	// Attribute is the unknown attribute
+	Attribute string
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionUnknownAttributeTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-unknown-attribute.dynamic-error",
+		Description: "unknown attribute in filter expression",
+		Other:       "Unknown attribute: '{{.Attribute}}'",
+	}
+}
+
+// FilterExpressionUnknownAttributeError unknown attribute in filter expression.
+type FilterExpressionUnknownAttributeError struct {
+	li18ngo.LocalisableError
+	FilterExpressionUnknownAttributeTemplData
+}
+
+// NewFilterExpressionUnknownAttributeError creates a new
+// FilterExpressionUnknownAttributeError.
+func NewFilterExpressionUnknownAttributeError(attribute string) error {
+	td := FilterExpressionUnknownAttributeTemplData{
+		agenorTemplData: agenorTemplData{},
+		Attribute:       attribute,
+	}
+	return &FilterExpressionUnknownAttributeError{
+		LocalisableError: li18ngo.LocalisableError{Data: td},
+		FilterExpressionUnknownAttributeTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterExpressionUnknownUnit
+//
+// FilterExpressionUnknownUnit indicates that a numeric literal within an
+// expression filter is qualified by a unit that is neither a size nor a
+// duration.
+// =============================================================================
+
+// FilterExpressionUnknownUnitTemplData unknown unit in filter expression.
+type FilterExpressionUnknownUnitTemplData struct {
+	agenorTemplData
+	// Unit is the unknown unit
+	Unit string
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterExpressionUnknownUnitTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-expression-unknown-unit.dynamic-error",
+		Description: "unknown unit in filter expression",
+		Other:       "Unknown unit: '{{.Unit}}'",
+	}
+}
+
+// FilterExpressionUnknownUnitError unknown unit in filter expression.
+type FilterExpressionUnknownUnitError struct {
+	li18ngo.LocalisableError
+	FilterExpressionUnknownUnitTemplData
+}
+
+// NewFilterExpressionUnknownUnitError creates a new
+// FilterExpressionUnknownUnitError.
+func NewFilterExpressionUnknownUnitError(unit string) error {
+	td := FilterExpressionUnknownUnitTemplData{
+		agenorTemplData: agenorTemplData{},
+		Unit:            unit,
+	}
+	return &FilterExpressionUnknownUnitError{
+		LocalisableError:                     li18ngo.LocalisableError{Data: td},
+		FilterExpressionUnknownUnitTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterIgnoreNotSupported
+//
+// FilterIgnoreNotSupported indicates that ignore filters can only be applied
+// to nodes, not to children or for sampling.
+// =============================================================================
+
+// FilterIgnoreNotSupportedErrorTemplData ignore filter only supported for
+// nodes.
+type FilterIgnoreNotSupportedErrorTemplData struct {
+	agenorTemplData
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterIgnoreNotSupportedErrorTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "ignore-filter-only-supported-for-nodes.static-error",
+		Description: "ignore filter only supported for nodes",
+		Other:       "Ignore filter only supported for nodes",
+	}
+}
+
+// FilterIgnoreNotSupportedError ignore filter only supported for nodes.
+type FilterIgnoreNotSupportedError struct {
+	li18ngo.LocalisableError
+}
+
+// ErrFilterIgnoreNotSupported is the exported sentinel error for
+// FilterIgnoreNotSupportedError.
+var ErrFilterIgnoreNotSupported = FilterIgnoreNotSupportedError{
+	LocalisableError: li18ngo.LocalisableError{
+		Data: FilterIgnoreNotSupportedErrorTemplData{},
+	},
+}
+
 // =============================================================================
 // ❌ FilterIsNil
 //
@@ -224,6 +598,84 @@ var ErrFilterMissingType = FilterMissingTypeError{
 	},
 }
 
+// =============================================================================
+// ❌ FilterNotRegistered
+//
+// FilterNotRegistered indicates that a named custom filter can not be
+// re-created, because no factory has been registered under its name.
+// =============================================================================
+
+// FilterNotRegisteredTemplData filter not registered.
+type FilterNotRegisteredTemplData struct {
+	agenorTemplData
+	// Name is the name of the unregistered filter
+	Name string
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterNotRegisteredTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-not-registered.dynamic-error",
+		Description: "filter not registered",
+		Other:       "Filter not registered, name: '{{.Name}}'",
+	}
+}
+
+// FilterNotRegisteredError filter not registered.
+type FilterNotRegisteredError struct {
+	li18ngo.LocalisableError
+	FilterNotRegisteredTemplData
+}
+
+// NewFilterNotRegisteredError creates a new FilterNotRegisteredError.
+func NewFilterNotRegisteredError(name string) error {
+	td := FilterNotRegisteredTemplData{
+		agenorTemplData: agenorTemplData{},
+		Name:            name,
+	}
+	return &FilterNotRegisteredError{
+		LocalisableError:             li18ngo.LocalisableError{Data: td},
+		FilterNotRegisteredTemplData: td,
+	}
+}
+
+// =============================================================================
+// ❌ FilterTrackerBreadthFirstNotSupported
+//
+// FilterTrackerBreadthFirstNotSupported indicates that a filter that follows
+// the progress of navigation, eg an ignore filter, can not be used when
+// navigating breadth first.
+// =============================================================================
+
+// FilterTrackerBreadthFirstNotSupportedErrorTemplData tracking filter not
+// supported for breadth first navigation.
+type FilterTrackerBreadthFirstNotSupportedErrorTemplData struct {
+	agenorTemplData
+}
+
+// Message creates a new i18n message using the template data.
+func (td FilterTrackerBreadthFirstNotSupportedErrorTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "filter-tracker-breadth-first-not-supported.static-error",
+		Description: "tracking filter not supported for breadth first navigation",
+		Other:       "Tracking filter not supported for breadth first navigation",
+	}
+}
+
+// FilterTrackerBreadthFirstNotSupportedError tracking filter not supported for
+// breadth first navigation.
+type FilterTrackerBreadthFirstNotSupportedError struct {
+	li18ngo.LocalisableError
+}
+
+// ErrFilterTrackerBreadthFirstNotSupported is the exported sentinel error for
+// FilterTrackerBreadthFirstNotSupportedError.
+var ErrFilterTrackerBreadthFirstNotSupported = FilterTrackerBreadthFirstNotSupportedError{
+	LocalisableError: li18ngo.LocalisableError{
+		Data: FilterTrackerBreadthFirstNotSupportedErrorTemplData{},
+	},
+}
+
 // =============================================================================
 // ❌ FilterUndefined
 //
@@ -344,6 +796,66 @@ func NewInvalidExtendedGlobPatternError(pattern string) error {
 	}
 }
 
+// =============================================================================
+// ❌ InvalidFilterExpression
+//
+// InvalidFilterExpression indicates that an expression filter definition could
+// not be parsed or type checked; the reason is the wrapped error.
+// =============================================================================
+
+// InvalidFilterExpressionTemplData invalid attribute filter expression wrapper
+// error.
+type InvalidFilterExpressionTemplData struct {
+	agenorTemplData
+	// Wrapped is the string representation of the wrapped error,
+	// used for go-i18n template interpolation via {{ .Wrapped }}.
+	Wrapped string
+	// Expression is the invalid filter expression
+	Expression string
+}
+
+// Message creates a new i18n message using the template data.
+func (td InvalidFilterExpressionTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-filter-expression.dynamic-error",
+		Description: "invalid attribute filter expression wrapper error",
+		Other:       "Invalid filter expression: '{{.Expression}}'",
+	}
+}
+
+// InvalidFilterExpressionError invalid attribute filter expression wrapper
+// error.
+type InvalidFilterExpressionError struct {
+	li18ngo.LocalisableError
+	InvalidFilterExpressionTemplData
+	wrapped error
+}
+
+// Error returns the combined wrapped and localised error message.
+func (e InvalidFilterExpressionError) Error() string {
+	return fmt.Sprintf("%v, %v", e.wrapped.Error(), li18ngo.Text(e.LocalisableError.Data))
+}
+
+// Unwrap returns the wrapped error.
+func (e InvalidFilterExpressionError) Unwrap() error {
+	return e.wrapped
+}
+
+// NewInvalidFilterExpressionError creates a new InvalidFilterExpressionError
+// wrapping wrapped.
+func NewInvalidFilterExpressionError(wrapped error, expression string) error {
+	td := InvalidFilterExpressionTemplData{
+		agenorTemplData: agenorTemplData{},
+		Wrapped:         wrapped.Error(),
+		Expression:      expression,
+	}
+	return &InvalidFilterExpressionError{
+		LocalisableError:                 li18ngo.LocalisableError{Data: td},
+		InvalidFilterExpressionTemplData: td,
+		wrapped:                          wrapped,
+	}
+}
+
 // =============================================================================
 // ❌ InvalidInCaseFilterDef
 //
@@ -472,3 +984,47 @@ var ErrPolyFilterIsInvalid = PolyFilterIsInvalidError{
 		Data: PolyFilterIsInvalidErrorTemplData{},
 	},
 }
+
+// =============================================================================
+// ❌ RegisteredFilterMismatch
+//
+// RegisteredFilterMismatch indicates that the factory registered for a named
+// custom filter created a filter of the wrong kind.
+// =============================================================================
+
+// RegisteredFilterMismatchTemplData registered filter is of the wrong kind.
+type RegisteredFilterMismatchTemplData struct {
+	agenorTemplData
+	// Name is the name of the registered filter
+	Name string
+	// Kind is the kind of filter required
+	Kind string
+}
+
+// Message creates a new i18n message using the template data.
+func (td RegisteredFilterMismatchTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "registered-filter-mismatch.dynamic-error",
+		Description: "registered filter is of the wrong kind",
+		Other:       "Registered filter: '{{.Name}}' is not a {{.Kind}} filter",
+	}
+}
+
+// RegisteredFilterMismatchError registered filter is of the wrong kind.
+type RegisteredFilterMismatchError struct {
+	li18ngo.LocalisableError
+	RegisteredFilterMismatchTemplData
+}
+
+// NewRegisteredFilterMismatchError creates a new RegisteredFilterMismatchError.
+func NewRegisteredFilterMismatchError(name, kind string) error {
+	td := RegisteredFilterMismatchTemplData{
+		agenorTemplData: agenorTemplData{},
+		Name:            name,
+		Kind:            kind,
+	}
+	return &RegisteredFilterMismatchError{
+		LocalisableError:                  li18ngo.LocalisableError{Data: td},
+		RegisteredFilterMismatchTemplData: td,
+	}
+}
@@ -67,6 +67,27 @@ func (td PipelineFlagDescTemplData) Message() *i18n.Message {
 	}
 }
 
+// =============================================================================
+// 🧊 RankFlagDesc
+//
+// Cobra flag description for rank flag
+// =============================================================================
+
+// RankFlagDescTemplData Cobra flag description for rank flag; values are
+// static so do not translate them.
+type RankFlagDescTemplData struct {
+	agenorTemplData
+}
+
+// Message returns the i18n message for RankFlagDescTemplData.
+func (td RankFlagDescTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "rank-flag-description",
+		Description: "Cobra flag description for rank flag; values are static so do not translate them",
+		Other:       "rank denotes the order in which sampled entries are selected: 'largest', 'smallest', 'newest' or 'oldest' (default: by name)",
+	}
+}
+
 // =============================================================================
 // 🧊 ResumeFlagDesc
 //
@@ -88,6 +109,26 @@ func (td ResumeFlagDescTemplData) Message() *i18n.Message {
 	}
 }
 
+// =============================================================================
+// 🧊 SampleGlobFlagDesc
+//
+// Cobra flag description for sample-glob flag
+// =============================================================================
+
+// SampleGlobFlagDescTemplData Cobra flag description for sample-glob flag.
+type SampleGlobFlagDescTemplData struct {
+	agenorTemplData
+}
+
+// Message returns the i18n message for SampleGlobFlagDescTemplData.
+func (td SampleGlobFlagDescTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "sample-glob-flag-description",
+		Description: "Cobra flag description for sample-glob flag",
+		Other:       "sample-glob denotes the extended glob pattern (eg '*|*.flac,*.jpg') that selects the files eligible for sampling",
+	}
+}
+
 // =============================================================================
 // 🧊 SubscribeFlagDesc
 //
@@ -189,6 +189,50 @@ func NewInvalidPathError(path string) error {
 	}
 }
 
+// =============================================================================
+// ❌ InvalidRankValue
+//
+// InvalidRankValue indicates that the sampling rank value supplied by the
+// caller is not in a valid value.
+// =============================================================================
+
+// InvalidRankValueTemplData Invalid rank value format error.
+type InvalidRankValueTemplData struct {
+	agenorTemplData
+	// Actual The rank value provided
+	Actual string
+	// Values The valid values for rank, composed together, probably as CSV
+	Values string
+}
+
+// Message creates a new i18n message using the template data.
+func (td InvalidRankValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-rank-value.dynamic-error",
+		Description: "Invalid rank value format error",
+		Other:       "Invalid rank value, actual: '{{.Actual}}', must be: {{.Values}}",
+	}
+}
+
+// InvalidRankValueError Invalid rank value format error.
+type InvalidRankValueError struct {
+	li18ngo.LocalisableError
+	InvalidRankValueTemplData
+}
+
+// NewInvalidRankValueError creates a new InvalidRankValueError.
+func NewInvalidRankValueError(actual string, values string) error {
+	td := InvalidRankValueTemplData{
+		agenorTemplData: agenorTemplData{},
+		Actual:          actual,
+		Values:          values,
+	}
+	return &InvalidRankValueError{
+		LocalisableError:          li18ngo.LocalisableError{Data: td},
+		InvalidRankValueTemplData: td,
+	}
+}
+
 // =============================================================================
 // ❌ InvalidResumeStrategy
 //
@@ -360,6 +404,81 @@ func NewPipelinePreflightFailureError(wrapped error, pipeline string) error {
 	}
 }
 
+// =============================================================================
+// ❌ RankWithLast
+//
+// RankWithLast indicates that sampling by last was requested along with a
+// rank, which already determines the direction in which entries are selected.
+// =============================================================================
+
+// RankWithLastErrorTemplData rank can not be combined with last.
+type RankWithLastErrorTemplData struct {
+	agenorTemplData
+}
+
+// Message creates a new i18n message using the template data.
+func (td RankWithLastErrorTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "rank-with-last.static-error",
+		Description: "rank can not be combined with last",
+		Other:       "Sampling rank can not be combined with last, the rank determines the order",
+	}
+}
+
+// RankWithLastError rank can not be combined with last.
+type RankWithLastError struct {
+	li18ngo.LocalisableError
+}
+
+// ErrRankWithLast is the exported sentinel error for
+// RankWithLastError.
+var ErrRankWithLast = RankWithLastError{
+	LocalisableError: li18ngo.LocalisableError{
+		Data: RankWithLastErrorTemplData{},
+	},
+}
+
+// =============================================================================
+// ❌ ResumeFileNotFound
+//
+// ResumeFileNotFound indicates that no resume file has been saved for the
+// tree, from which to resume.
+// =============================================================================
+
+// ResumeFileNotFoundTemplData resume file not found.
+type ResumeFileNotFoundTemplData struct {
+	agenorTemplData
+	// Tree is the tree for which no resume file was found
+	Tree string
+}
+
+// Message creates a new i18n message using the template data.
+func (td ResumeFileNotFoundTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "resume-file-not-found.dynamic-error",
+		Description: "resume file not found",
+		Other:       "No resume file found for tree: '{{.Tree}}'",
+	}
+}
+
+// ResumeFileNotFoundError resume file not found.
+type ResumeFileNotFoundError struct {
+	li18ngo.LocalisableError
+	ResumeFileNotFoundTemplData
+}
+
+// NewResumeFileNotFoundError creates a new ResumeFileNotFoundError.
+func NewResumeFileNotFoundError(tree string) error {
+	td := ResumeFileNotFoundTemplData{
+		agenorTemplData: agenorTemplData{},
+		Tree:            tree,
+	}
+	return &ResumeFileNotFoundError{
+		LocalisableError:            li18ngo.LocalisableError{Data: td},
+		ResumeFileNotFoundTemplData: td,
+	}
+}
+
 // =============================================================================
 // ❌ ResumeFsMismatch
 //
@@ -544,6 +663,51 @@ var ErrTraverseFsMismatch = TraverseFsMismatchError{
 	},
 }
 
+// =============================================================================
+// ❌ TreeDrift
+//
+// TreeDrift indicates that a directory on the ancestor chain of the node from
+// which navigation resumes has been renamed, deleted or modified since the
+// navigation state was saved.
+// =============================================================================
+
+// TreeDriftTemplData tree has drifted since navigation state was saved.
+type TreeDriftTemplData struct {
+	agenorTemplData
+	// Path is the path of the directory that has drifted
+	Path string
+	// Reason describes how the directory has drifted
+	Reason string
+}
+
+// Message creates a new i18n message using the template data.
+func (td TreeDriftTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "tree-drift.dynamic-error",
+		Description: "tree has drifted since navigation state was saved",
+		Other:       "Tree has drifted, path: '{{.Path}}', reason: '{{.Reason}}'",
+	}
+}
+
+// TreeDriftError tree has drifted since navigation state was saved.
+type TreeDriftError struct {
+	li18ngo.LocalisableError
+	TreeDriftTemplData
+}
+
+// NewTreeDriftError creates a new TreeDriftError.
+func NewTreeDriftError(path, reason string) error {
+	td := TreeDriftTemplData{
+		agenorTemplData: agenorTemplData{},
+		Path:            path,
+		Reason:          reason,
+	}
+	return &TreeDriftError{
+		LocalisableError:   li18ngo.LocalisableError{Data: td},
+		TreeDriftTemplData: td,
+	}
+}
+
 // =============================================================================
 // ❌ UnEqualJSONConversion
 //
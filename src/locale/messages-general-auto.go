@@ -113,6 +113,41 @@ func NewActionVisitedTemplData(name string, path string) ActionVisitedTemplData
 	}
 }
 
+// =============================================================================
+// 📨 HibernationTrigger
+//
+// HibernationTrigger describes a hibernation wake or sleep condition based on
+// a count, depth or time limit.
+// =============================================================================
+
+// HibernationTriggerTemplData Describes a hibernation trigger that is not
+// based on a filter.
+type HibernationTriggerTemplData struct {
+	agenorTemplData
+	// Trigger is the kind of trigger
+	Trigger string
+	// Limit is the limit at which the trigger fires
+	Limit string
+}
+
+// Message returns the i18n message for HibernationTriggerTemplData.
+func (td HibernationTriggerTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "hibernation-trigger",
+		Description: "Describes a hibernation trigger that is not based on a filter",
+		Other:       "{{.Trigger}}: {{.Limit}}",
+	}
+}
+
+// NewHibernationTriggerTemplData creates a new HibernationTriggerTemplData.
+func NewHibernationTriggerTemplData(trigger string, limit string) HibernationTriggerTemplData {
+	return HibernationTriggerTemplData{
+		agenorTemplData: agenorTemplData{},
+		Trigger:         trigger,
+		Limit:           limit,
+	}
+}
+
 // =============================================================================
 // 📨 NodeVisited
 //
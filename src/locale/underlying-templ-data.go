@@ -86,6 +86,29 @@ var _ = lingo.Underliers{
 		File: "flags",
 	},
 
+	"rank-flag-description": {
+		MessageID: "rank-flag-description",
+		Seed:      "RankFlagDesc",
+		TypeName:  enums.UnderlyingTypeStaticCobra,
+		Description: "Cobra flag description for rank flag; values are static " +
+			"so do not translate them",
+		Story: "Cobra flag description for rank flag",
+		Other: "rank denotes the order in which sampled entries are selected: " +
+			"'largest', 'smallest', 'newest' or 'oldest' (default: by name)",
+		File: "flags",
+	},
+
+	"sample-glob-flag-description": {
+		MessageID:   "sample-glob-flag-description",
+		Seed:        "SampleGlobFlagDesc",
+		TypeName:    enums.UnderlyingTypeStaticCobra,
+		Description: "Cobra flag description for sample-glob flag",
+		Story:       "Cobra flag description for sample-glob flag",
+		Other: "sample-glob denotes the extended glob pattern (eg '*|*.flac,*.jpg') " +
+			"that selects the files eligible for sampling",
+		File: "flags",
+	},
+
 	"dry-run-flag-description": {
 		MessageID:   "dry-run-flag-description",
 		Seed:        "DryRunFlagDesc",
@@ -623,6 +646,29 @@ Use --action or --pipeline to name a config-defined operation.
 		File:  "filter",
 	},
 
+	"ignore-filter-only-supported-for-nodes.static-error": {
+		MessageID:   "ignore-filter-only-supported-for-nodes.static-error",
+		Seed:        "FilterIgnoreNotSupported",
+		TypeName:    enums.UnderlyingTypeStaticError,
+		Description: "ignore filter only supported for nodes",
+		Story: "FilterIgnoreNotSupported indicates that ignore filters" +
+			" can only be applied to nodes, not to children or for sampling.",
+		Other: "Ignore filter only supported for nodes",
+		File:  "filter",
+	},
+
+	"filter-tracker-breadth-first-not-supported.static-error": {
+		MessageID:   "filter-tracker-breadth-first-not-supported.static-error",
+		Seed:        "FilterTrackerBreadthFirstNotSupported",
+		TypeName:    enums.UnderlyingTypeStaticError,
+		Description: "tracking filter not supported for breadth first navigation",
+		Story: "FilterTrackerBreadthFirstNotSupported indicates that a" +
+			" filter that follows the progress of navigation, eg an ignore" +
+			" filter, can not be used when navigating breadth first.",
+		Other: "Tracking filter not supported for breadth first navigation",
+		File:  "filter",
+	},
+
 	"glob-ex-filter-not-supported-for-children.static-error": {
 		MessageID:   "glob-ex-filter-not-supported-for-children.static-error",
 		Seed:        "FilterChildGlobExNotSupported",
@@ -662,6 +708,210 @@ Use --action or --pipeline to name a config-defined operation.
 		File: "filter",
 	},
 
+	"filter-expression-invalid-number.dynamic-error": {
+		MessageID:   "filter-expression-invalid-number.dynamic-error",
+		Seed:        "FilterExpressionInvalidNumber",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "invalid number in filter expression",
+		Story: "FilterExpressionInvalidNumber indicates that a numeric" +
+			" literal within an expression filter can not be parsed.",
+		Other: "Invalid number: '{{.Number}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Number",
+				GoType: "string",
+				Tale:   "is the invalid numeric literal",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-expression-kind-mismatch.dynamic-error": {
+		MessageID:   "filter-expression-kind-mismatch.dynamic-error",
+		Seed:        "FilterExpressionKindMismatch",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "mismatched operand kind in filter expression",
+		Story: "FilterExpressionKindMismatch indicates that an operand" +
+			" within an expression filter is not of the kind required by" +
+			" its context, eg a size compared with a duration.",
+		Other: "Kind mismatch for '{{.Context}}', expected: '{{.Expected}}', actual: '{{.Actual}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Context",
+				GoType: "string",
+				Tale:   "is the operator or expression requiring the operand",
+			},
+			{
+				Note:   "Expected",
+				GoType: "string",
+				Tale:   "is the kind of operand required",
+			},
+			{
+				Note:   "Actual",
+				GoType: "string",
+				Tale:   "is the kind of operand found",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-expression-missing-parenthesis.dynamic-error": {
+		MessageID:   "filter-expression-missing-parenthesis.dynamic-error",
+		Seed:        "FilterExpressionMissingParenthesis",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "missing closing parenthesis in filter expression",
+		Story: "FilterExpressionMissingParenthesis indicates that a" +
+			" parenthesised group within an expression filter is not" +
+			" closed.",
+		Other: "Missing ')' for '(' at position: '{{.Position}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Position",
+				GoType: "int",
+				Tale:   "is the position of the unmatched opening parenthesis",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-expression-unexpected-end.static-error": {
+		MessageID:   "filter-expression-unexpected-end.static-error",
+		Seed:        "FilterExpressionUnexpectedEnd",
+		TypeName:    enums.UnderlyingTypeStaticError,
+		Description: "unexpected end of filter expression",
+		Story: "FilterExpressionUnexpectedEnd indicates that an expression" +
+			" filter ends where an operand is required.",
+		Other: "Unexpected end of expression",
+		File:  "filter",
+	},
+
+	"filter-expression-unexpected-token.dynamic-error": {
+		MessageID:   "filter-expression-unexpected-token.dynamic-error",
+		Seed:        "FilterExpressionUnexpectedToken",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "unexpected token in filter expression",
+		Story: "FilterExpressionUnexpectedToken indicates that an expression" +
+			" filter contains a token that is not valid at its position.",
+		Other: "Unexpected '{{.Token}}' at position: '{{.Position}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Token",
+				GoType: "string",
+				Tale:   "is the unexpected token",
+			},
+			{
+				Note:   "Position",
+				GoType: "int",
+				Tale:   "is the position of the token within the expression",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-expression-unknown-attribute.dynamic-error": {
+		MessageID:   "filter-expression-unknown-attribute.dynamic-error",
+		Seed:        "FilterExpressionUnknownAttribute",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "unknown attribute in filter expression",
+		Story: "FilterExpressionUnknownAttribute indicates that an" +
+			" expression filter refers to an attribute that is not" +
+			" defined.",
+		Other: "Unknown attribute: '{{.Attribute}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Attribute",
+				GoType: "string",
+				Tale:   "is the unknown attribute",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-expression-unknown-unit.dynamic-error": {
+		MessageID:   "filter-expression-unknown-unit.dynamic-error",
+		Seed:        "FilterExpressionUnknownUnit",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "unknown unit in filter expression",
+		Story: "FilterExpressionUnknownUnit indicates that a numeric literal" +
+			" within an expression filter is qualified by a unit that is" +
+			" neither a size nor a duration.",
+		Other: "Unknown unit: '{{.Unit}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Unit",
+				GoType: "string",
+				Tale:   "is the unknown unit",
+			},
+		},
+		File: "filter",
+	},
+
+	"invalid-filter-expression.dynamic-error": {
+		MessageID:   "invalid-filter-expression.dynamic-error",
+		Seed:        "InvalidFilterExpression",
+		TypeName:    enums.UnderlyingTypeDynamicErrorWrapper,
+		Description: "invalid attribute filter expression wrapper error",
+		Story: "InvalidFilterExpression indicates that an expression" +
+			" filter definition could not be parsed or type checked;" +
+			" the reason is the wrapped error.",
+		Other: "Invalid filter expression: '{{.Expression}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Wrapped",
+				GoType: "error",
+				Tale:   "is the reason the expression is invalid",
+			},
+			{
+				Note:   "Expression",
+				GoType: "string",
+				Tale:   "is the invalid filter expression",
+			},
+		},
+		File: "filter",
+	},
+
+	"filter-not-registered.dynamic-error": {
+		MessageID:   "filter-not-registered.dynamic-error",
+		Seed:        "FilterNotRegistered",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "filter not registered",
+		Story: "FilterNotRegistered indicates that a named custom filter" +
+			" can not be re-created, because no factory has been registered" +
+			" under its name.",
+		Other: "Filter not registered, name: '{{.Name}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Name",
+				GoType: "string",
+				Tale:   "is the name of the unregistered filter",
+			},
+		},
+		File: "filter",
+	},
+
+	"registered-filter-mismatch.dynamic-error": {
+		MessageID:   "registered-filter-mismatch.dynamic-error",
+		Seed:        "RegisteredFilterMismatch",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "registered filter is of the wrong kind",
+		Story: "RegisteredFilterMismatch indicates that the factory registered" +
+			" for a named custom filter created a filter of the wrong kind.",
+		Other: "Registered filter: '{{.Name}}' is not a {{.Kind}} filter",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Name",
+				GoType: "string",
+				Tale:   "is the name of the registered filter",
+			},
+			{
+				Note:   "Kind",
+				GoType: "string",
+				Tale:   "is the kind of filter required",
+			},
+		},
+		File: "filter",
+	},
+
 	// -------------------------------------------------------------------------
 	// filter: Error messages
 	// -------------------------------------------------------------------------
@@ -715,6 +965,18 @@ Use --action or --pipeline to name a config-defined operation.
 		File: "filter",
 	},
 
+	"compound-filter-is-invalid.static-error": {
+		MessageID:   "compound-filter-is-invalid.static-error",
+		Seed:        "CompoundFilterIsInvalid",
+		TypeName:    enums.UnderlyingTypeStaticError,
+		Description: "compound filter definition is invalid error",
+		Story: "CompoundFilterIsInvalid indicates that a compound filter" +
+			" definition fails validation; eg it is missing, has no" +
+			" constituents or an undefined mode.",
+		Other: "compound filter definition is invalid",
+		File:  "filter",
+	},
+
 	"poly-filter-is-invalid.static-error": {
 		MessageID:   "poly-filter-is-invalid.static-error",
 		Seed:        "PolyFilterIsInvalid",
@@ -1020,6 +1282,28 @@ Use --action or --pipeline to name a config-defined operation.
 		},
 	},
 
+	"hibernation-trigger": {
+		MessageID:   "hibernation-trigger",
+		Seed:        "HibernationTrigger",
+		TypeName:    enums.UnderlyingTypeDynamicGeneral,
+		Description: "Describes a hibernation trigger that is not based on a filter",
+		Story: "HibernationTrigger describes a hibernation wake or sleep" +
+			" condition based on a count, depth or time limit.",
+		Other: "{{.Trigger}}: {{.Limit}}",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Trigger",
+				GoType: "string",
+				Tale:   "is the kind of trigger",
+			},
+			{
+				Note:   "Limit",
+				GoType: "string",
+				Tale:   "is the limit at which the trigger fires",
+			},
+		},
+	},
+
 	// -------------------------------------------------------------------------
 	// Error messages
 	// -------------------------------------------------------------------------
@@ -1155,6 +1439,23 @@ Use --action or --pipeline to name a config-defined operation.
 		Other: "resume-fs file system mismatch",
 	},
 
+	"resume-file-not-found.dynamic-error": {
+		MessageID:   "resume-file-not-found.dynamic-error",
+		Seed:        "ResumeFileNotFound",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "resume file not found",
+		Story: "ResumeFileNotFound indicates that no resume file has" +
+			" been saved for the tree, from which to resume.",
+		Other: "No resume file found for tree: '{{.Tree}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Tree",
+				GoType: "string",
+				Tale:   "is the tree for which no resume file was found",
+			},
+		},
+	},
+
 	"resume-fs-mismatch.sentinel-error": {
 		MessageID:   "resume-fs-mismatch.sentinel-error",
 		Seed:        "CoreResumeFsMismatch",
@@ -1220,6 +1521,29 @@ Use --action or --pipeline to name a config-defined operation.
 		},
 	},
 
+	"tree-drift.dynamic-error": {
+		MessageID:   "tree-drift.dynamic-error",
+		Seed:        "TreeDrift",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "tree has drifted since navigation state was saved",
+		Story: "TreeDrift indicates that a directory on the ancestor chain" +
+			" of the node from which navigation resumes has been renamed," +
+			" deleted or modified since the navigation state was saved.",
+		Other: "Tree has drifted, path: '{{.Path}}', reason: '{{.Reason}}'",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Path",
+				GoType: "string",
+				Tale:   "is the path of the directory that has drifted",
+			},
+			{
+				Note:   "Reason",
+				GoType: "string",
+				Tale:   "describes how the directory has drifted",
+			},
+		},
+	},
+
 	"traversal-not-saved.dynamic-error": {
 		MessageID: "traversal-not-saved.dynamic-error",
 		Seed:      "TraversalNotSaved",
@@ -1265,6 +1589,39 @@ Use --action or --pipeline to name a config-defined operation.
 		},
 	},
 
+	"invalid-rank-value.dynamic-error": {
+		MessageID:   "invalid-rank-value.dynamic-error",
+		Seed:        "InvalidRankValue",
+		TypeName:    enums.UnderlyingTypeDynamicError,
+		Description: "Invalid rank value format error",
+		Story: "InvalidRankValue indicates that the sampling rank value supplied by the caller" +
+			" is not in a valid value.",
+		Other: "Invalid rank value, actual: '{{.Actual}}', must be: {{.Values}}",
+		Fields: []lingo.UnderlyingField{
+			{
+				Note:   "Actual",
+				GoType: "string",
+				Tale:   "The rank value provided",
+			},
+			{
+				Note:   "Values",
+				GoType: "string",
+				Tale:   "The valid values for rank, composed together, probably as CSV",
+			},
+		},
+	},
+
+	"rank-with-last.static-error": {
+		MessageID:   "rank-with-last.static-error",
+		Seed:        "RankWithLast",
+		TypeName:    enums.UnderlyingTypeStaticError,
+		Description: "rank can not be combined with last",
+		Story: "RankWithLast indicates that sampling by last was requested along" +
+			" with a rank, which already determines the direction in which" +
+			" entries are selected.",
+		Other: "Sampling rank can not be combined with last, the rank determines the order",
+	},
+
 	// words
 
 	"prohibitive.word": {
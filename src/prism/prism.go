@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 )
 
 // Dependency rule for prism view packages:
@@ -57,6 +58,17 @@ type SurveyResult struct {
 	MaxDepth uint
 }
 
+// NewSurveyResult creates a SurveyResult from the outcome of an agenor
+// survey, counting the nodes that will be visited for the subscription.
+func NewSurveyResult(survey *core.SurveyResult,
+	subscription enums.Subscription,
+) *SurveyResult {
+	return &SurveyResult{
+		NodeCount: survey.Count(subscription),
+		MaxDepth:  uint(survey.MaxDepth),
+	}
+}
+
 // Overture carries the metadata known at the start of a traversal.
 // Passed to Renderer.Begin to render the opening display.
 type Overture struct {
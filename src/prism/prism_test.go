@@ -6,10 +6,34 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/snivilised/jaywalk/src/agenor/core"
+	"github.com/snivilised/jaywalk/src/agenor/enums"
 	"github.com/snivilised/jaywalk/src/prism"
 	"github.com/snivilised/jaywalk/src/prism/flow"
 )
 
+var _ = Describe("NewSurveyResult", func() {
+	survey := &core.SurveyResult{
+		Files:       7,
+		Directories: 3,
+		Bytes:       1024,
+		MaxDepth:    2,
+	}
+
+	DescribeTable("counts the nodes for the subscription",
+		func(subscription enums.Subscription, expected uint) {
+			result := prism.NewSurveyResult(survey, subscription)
+
+			Expect(result.NodeCount).To(Equal(expected))
+			Expect(result.MaxDepth).To(Equal(uint(2)))
+		},
+		Entry("files", enums.SubscribeFiles, uint(7)),
+		Entry("directories", enums.SubscribeDirectories, uint(3)),
+		Entry("directories with files", enums.SubscribeDirectoriesWithFiles, uint(3)),
+		Entry("universal", enums.SubscribeUniversal, uint(10)),
+	)
+})
+
 var _ = Describe("New", func() {
 	BeforeEach(func() {
 		flow.Register()
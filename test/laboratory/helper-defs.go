@@ -111,6 +111,9 @@ type (
 
 		// Sample captures a sample traversal filter that can be used for testing purposes.
 		Sample core.SampleTraverseFilter
+
+		// Poly captures the file and directory constituents of a poly filter.
+		Poly *core.PolyFilterDef
 	}
 
 	// FilterErrataTE captures parameters for tests related to filter errata, which are